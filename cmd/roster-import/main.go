@@ -0,0 +1,103 @@
+// Command roster-import imports a CSV or XLSX player roster.
+//
+// Usage:
+//
+//	roster-import -file roster.xlsx [-format xlsx] [-map name=이름,team=소속팀] [-commit] [-upsert]
+//
+// Without -commit the roster is only validated (dry run). Remote profile image URLs are checked but
+// not mirrored by this command; the periodic link check of the server mirrors them. The report is written to stdout as JSON,
+// and the command exits with status 1 when any row was rejected.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"player_management_system/config"
+	"player_management_system/internal/pkg/auth"
+	platformPostgres "player_management_system/internal/platform/postgres"
+	"player_management_system/internal/repositories/player/postgres"
+	"player_management_system/internal/services/player"
+	"player_management_system/internal/services/profileimage"
+	"player_management_system/internal/services/roster"
+)
+
+func main() {
+	filename := flag.String("file", "", "roster file (CSV or XLSX)")
+	format := flag.String("format", "", "roster format, defaults to the file extension")
+	mapping := flag.String("map", "", "column mapping as field=column pairs separated by commas")
+	commit := flag.Bool("commit", false, "store the players instead of only validating them")
+	upsert := flag.Bool("upsert", false, "update existing players matched on external_id")
+	flag.Parse()
+
+	if *filename == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	opts := roster.Options{DryRun: !*commit, Upsert: *upsert}
+
+	var err error
+	if *format != "" {
+		opts.Format, err = roster.ParseFormat(*format)
+	} else {
+		opts.Format, err = roster.FormatFromFilename(*filename)
+	}
+	if err != nil {
+		log.Fatalf("Invalid format: %v", err)
+	}
+
+	if opts.Mapping, err = roster.ParseColumnMapping(*mapping); err != nil {
+		log.Fatalf("Invalid mapping: %v", err)
+	}
+
+	file, err := os.Open(*filename)
+	if err != nil {
+		log.Fatalf("Failed to open roster: %v", err)
+	}
+	defer file.Close()
+
+	// Load configuration
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	// Connect to the database
+	db, err := platformPostgres.New(platformPostgres.Config{
+		Host:     cfg.DBHost,
+		Port:     cfg.DBPort,
+		User:     cfg.DBUser,
+		Password: cfg.DBPassword,
+		DBName:   cfg.DBName,
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	// 이미지 복사는 서버가 하므로 여기서는 프로필 이미지 URL만 확인함
+	urlChecker := profileimage.NewRemoteImageService(nil, nil, profileimage.RemoteConfig{})
+	importer := roster.NewImporter(player.NewPlayerService(postgres.NewPlayerRepository(db)), roster.WithRemoteImages(urlChecker))
+
+	// 명령줄 도구는 데이터베이스 접근 권한을 가진 운영자가 실행하므로 관리자로 취급
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "cli:roster-import", Roles: []string{auth.RoleAdmin}})
+
+	report, err := importer.Import(ctx, file, opts)
+	if err != nil {
+		log.Fatalf("Failed to import roster: %v", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+
+	if len(report.Errors) > 0 {
+		os.Exit(1)
+	}
+}
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/spf13/viper"
-	"log"
 )
 
 // Config represents the application configuration.
@@ -13,6 +15,79 @@ type Config struct {
 	DBPassword string `mapstructure:"DB_PASSWORD"`
 	DBName     string `mapstructure:"DB_NAME"`
 	Port       string `mapstructure:"PORT"`
+	// MetricsPort is the port of the admin server, which serves /metrics without authentication and
+	// must not be exposed to the public network. The player gauge is refreshed every MetricsRefreshInterval.
+	MetricsPort            string        `mapstructure:"METRICS_PORT"`
+	MetricsRefreshInterval time.Duration `mapstructure:"METRICS_REFRESH_INTERVAL"`
+	// TrustedProxies are the CIDR ranges of the reverse proxies whose X-Forwarded-For is believed
+	// when finding the client IP address; with none, the address of the connection is used.
+	TrustedProxies []string `mapstructure:"TRUSTED_PROXIES"`
+	// ShutdownTimeout bounds draining in-flight requests and stopping background jobs on SIGTERM.
+	ShutdownTimeout time.Duration `mapstructure:"SHUTDOWN_TIMEOUT"`
+	// ShutdownDelay is how long /readyz fails before the server stops accepting connections,
+	// which should cover the readiness probe period of the load balancer.
+	ShutdownDelay time.Duration `mapstructure:"SHUTDOWN_DELAY"`
+	// HealthCheckTimeout bounds each component check of /readyz.
+	HealthCheckTimeout time.Duration `mapstructure:"HEALTH_CHECK_TIMEOUT"`
+
+	// Tracing; spans are exported over OTLP/HTTP to TracingEndpoint, such as http://localhost:4318,
+	// and not exported when it is empty.
+	TracingEndpoint    string  `mapstructure:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	TracingServiceName string  `mapstructure:"OTEL_SERVICE_NAME"`
+	TracingSampleRatio float64 `mapstructure:"TRACE_SAMPLE_RATIO"`
+
+	// Logging; LogSampleRatio is the fraction of requests whose debug and info records are written.
+	LogLevel       string  `mapstructure:"LOG_LEVEL"`
+	LogFormat      string  `mapstructure:"LOG_FORMAT"`
+	LogSampleRatio float64 `mapstructure:"LOG_SAMPLE_RATIO"`
+
+	// JWT verification keys; at least one must be set.
+	JWTHMACSecret       string `mapstructure:"JWT_HS256_SECRET"`
+	JWTRSAPublicKeyFile string `mapstructure:"JWT_RSA_PUBLIC_KEY_FILE"`
+	JWTJWKSFile         string `mapstructure:"JWT_JWKS_FILE"`
+	JWTIssuer           string `mapstructure:"JWT_ISSUER"`
+	JWTAudience         string `mapstructure:"JWT_AUDIENCE"`
+
+	// Rate limiting; the store is "memory" for a single instance or "postgres" for several replicas.
+	// The IP limit applies to every request before authentication, the others to each client.
+	// Full buckets are deleted from Postgres every RateLimitSweepInterval.
+	RateLimitStore         string        `mapstructure:"RATE_LIMIT_STORE"`
+	RateLimitReadRate      float64       `mapstructure:"RATE_LIMIT_READ_RATE"`
+	RateLimitReadBurst     int           `mapstructure:"RATE_LIMIT_READ_BURST"`
+	RateLimitWriteRate     float64       `mapstructure:"RATE_LIMIT_WRITE_RATE"`
+	RateLimitWriteBurst    int           `mapstructure:"RATE_LIMIT_WRITE_BURST"`
+	RateLimitIPRate        float64       `mapstructure:"RATE_LIMIT_IP_RATE"`
+	RateLimitIPBurst       int           `mapstructure:"RATE_LIMIT_IP_BURST"`
+	RateLimitSweepInterval time.Duration `mapstructure:"RATE_LIMIT_SWEEP_INTERVAL"`
+
+	// Idempotency keys; the store is "memory" or "postgres" like the rate limit store.
+	// Expired keys are deleted from Postgres every IdempotencySweepInterval.
+	IdempotencyStore         string        `mapstructure:"IDEMPOTENCY_STORE"`
+	IdempotencyTTL           time.Duration `mapstructure:"IDEMPOTENCY_TTL"`
+	IdempotencySweepInterval time.Duration `mapstructure:"IDEMPOTENCY_SWEEP_INTERVAL"`
+
+	// Uploaded images; the store is "local" or "s3". Local files are written to ImageLocalDir,
+	// which a static file server is expected to serve at ImageBaseURL.
+	ImageStore    string `mapstructure:"IMAGE_STORE"`
+	ImageMaxSize  int64  `mapstructure:"IMAGE_MAX_SIZE"`
+	ImageLocalDir string `mapstructure:"IMAGE_LOCAL_DIR"`
+	ImageBaseURL  string `mapstructure:"IMAGE_BASE_URL"`
+	// Remote profile image URLs are mirrored into the image store when ImageMirrorRemote is set,
+	// and those still remote are checked every ImageLinkCheckInterval; zero disables the checks.
+	ImageMirrorRemote      bool          `mapstructure:"IMAGE_MIRROR_REMOTE"`
+	ImageLinkCheckInterval time.Duration `mapstructure:"IMAGE_LINK_CHECK_INTERVAL"`
+
+	// S3-compatible image storage, used when ImageStore is "s3".
+	S3Endpoint        string `mapstructure:"S3_ENDPOINT"`
+	S3Region          string `mapstructure:"S3_REGION"`
+	S3Bucket          string `mapstructure:"S3_BUCKET"`
+	S3AccessKeyID     string `mapstructure:"S3_ACCESS_KEY_ID"`
+	S3SecretAccessKey string `mapstructure:"S3_SECRET_ACCESS_KEY"`
+	S3PublicURL       string `mapstructure:"S3_PUBLIC_URL"`
+
+	// EnvFile is the .env file the configuration was read from, empty when there was none. It is
+	// reported by the caller once logging is configured.
+	EnvFile string `mapstructure:"-"`
 }
 
 // LoadConfig loads the configuration from the .env file.
@@ -25,14 +100,52 @@ func LoadConfig() (Config, error) {
 	viper.SetDefault("DB_HOST", "localhost")
 	viper.SetDefault("DB_PORT", "5430")
 	viper.SetDefault("PORT", "8080")
+	viper.SetDefault("METRICS_PORT", "9090")
+	viper.SetDefault("METRICS_REFRESH_INTERVAL", "1m")
+	viper.SetDefault("TRUSTED_PROXIES", "")
+	viper.SetDefault("SHUTDOWN_TIMEOUT", "30s")
+	viper.SetDefault("SHUTDOWN_DELAY", "0s")
+	viper.SetDefault("HEALTH_CHECK_TIMEOUT", "2s")
+	viper.SetDefault("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	viper.SetDefault("OTEL_SERVICE_NAME", "player-management-system")
+	viper.SetDefault("TRACE_SAMPLE_RATIO", 1)
+	viper.SetDefault("LOG_LEVEL", "info")
+	viper.SetDefault("LOG_FORMAT", "json")
+	viper.SetDefault("LOG_SAMPLE_RATIO", 1)
+	viper.SetDefault("JWT_HS256_SECRET", "")
+	viper.SetDefault("JWT_RSA_PUBLIC_KEY_FILE", "")
+	viper.SetDefault("JWT_JWKS_FILE", "")
+	viper.SetDefault("JWT_ISSUER", "")
+	viper.SetDefault("JWT_AUDIENCE", "")
+	viper.SetDefault("RATE_LIMIT_STORE", "memory")
+	viper.SetDefault("RATE_LIMIT_READ_RATE", 20)
+	viper.SetDefault("RATE_LIMIT_READ_BURST", 40)
+	viper.SetDefault("RATE_LIMIT_WRITE_RATE", 5)
+	viper.SetDefault("RATE_LIMIT_WRITE_BURST", 10)
+	viper.SetDefault("RATE_LIMIT_IP_RATE", 50)
+	viper.SetDefault("RATE_LIMIT_IP_BURST", 100)
+	viper.SetDefault("RATE_LIMIT_SWEEP_INTERVAL", "1m")
+	viper.SetDefault("IDEMPOTENCY_STORE", "memory")
+	viper.SetDefault("IDEMPOTENCY_TTL", "24h")
+	viper.SetDefault("IDEMPOTENCY_SWEEP_INTERVAL", "10m")
+	viper.SetDefault("IMAGE_STORE", "local")
+	viper.SetDefault("IMAGE_MAX_SIZE", 5<<20)
+	viper.SetDefault("IMAGE_LOCAL_DIR", "./data/images")
+	viper.SetDefault("IMAGE_BASE_URL", "/images")
+	viper.SetDefault("IMAGE_MIRROR_REMOTE", true)
+	viper.SetDefault("IMAGE_LINK_CHECK_INTERVAL", "24h")
+	viper.SetDefault("S3_ENDPOINT", "")
+	viper.SetDefault("S3_REGION", "us-east-1")
+	viper.SetDefault("S3_BUCKET", "")
+	viper.SetDefault("S3_ACCESS_KEY_ID", "")
+	viper.SetDefault("S3_SECRET_ACCESS_KEY", "")
+	viper.SetDefault("S3_PUBLIC_URL", "")
 
 	viper.AutomaticEnv() // Enable automatically binding environment variables
 
 	err := viper.ReadInConfig()
 	if err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
-			log.Println(".env file not found, using environment variables or defaults")
-		} else {
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
 			return Config{}, err
 		}
 	}
@@ -43,5 +156,45 @@ func LoadConfig() (Config, error) {
 		return Config{}, err
 	}
 
+	if err := config.validate(); err != nil {
+		return Config{}, err
+	}
+	config.EnvFile = viper.ConfigFileUsed()
+
 	return config, nil
 }
+
+// validate rejects settings that would break the server at run time.
+func (c Config) validate() error {
+	limits := []struct {
+		name  string
+		rate  float64
+		burst int
+	}{
+		{"RATE_LIMIT_READ", c.RateLimitReadRate, c.RateLimitReadBurst},
+		{"RATE_LIMIT_WRITE", c.RateLimitWriteRate, c.RateLimitWriteBurst},
+		{"RATE_LIMIT_IP", c.RateLimitIPRate, c.RateLimitIPBurst},
+	}
+	for _, l := range limits {
+		// 토큰 버킷은 비율로 나누므로 0 이하는 허용하지 않음
+		if l.rate <= 0 {
+			return fmt.Errorf("%s_RATE must be positive, got %v", l.name, l.rate)
+		}
+		if l.burst < 1 {
+			return fmt.Errorf("%s_BURST must be at least 1, got %d", l.name, l.burst)
+		}
+	}
+	if c.MetricsPort == c.Port {
+		return fmt.Errorf("METRICS_PORT must differ from PORT, got %s", c.MetricsPort)
+	}
+	if c.MetricsRefreshInterval <= 0 {
+		return fmt.Errorf("METRICS_REFRESH_INTERVAL must be positive, got %v", c.MetricsRefreshInterval)
+	}
+	if c.RateLimitSweepInterval <= 0 {
+		return fmt.Errorf("RATE_LIMIT_SWEEP_INTERVAL must be positive, got %v", c.RateLimitSweepInterval)
+	}
+	if c.IdempotencySweepInterval <= 0 {
+		return fmt.Errorf("IDEMPOTENCY_SWEEP_INTERVAL must be positive, got %v", c.IdempotencySweepInterval)
+	}
+	return nil
+}
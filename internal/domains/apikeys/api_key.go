@@ -0,0 +1,104 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"player_management_system/internal/pkg/auth"
+	"player_management_system/internal/pkg/errors"
+)
+
+// Scopes that can be granted to an API key.
+const (
+	ScopePlayersRead  = auth.ScopePlayersRead
+	ScopePlayersWrite = auth.ScopePlayersWrite
+	ScopeMediaWrite   = auth.ScopeMediaWrite
+)
+
+// Scopes lists every valid scope.
+var Scopes = []string{ScopePlayersRead, ScopePlayersWrite, ScopeMediaWrite}
+
+// keyPrefix marks the keys issued by this system, which helps secret scanners.
+const keyPrefix = "pms_"
+
+// APIKey represents a long-lived credential issued to a partner integration.
+// Only the SHA-256 hash of the key is stored; the key itself is shown once when issued.
+type APIKey struct {
+	ID         uuid.UUID  `json:"id" db:"id"`
+	Name       string     `json:"name" db:"name"`
+	Owner      string     `json:"owner" db:"owner"`
+	Prefix     string     `json:"prefix" db:"prefix"`
+	Hash       string     `json:"-" db:"key_hash"`
+	Scopes     []string   `json:"scopes" db:"-"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
+
+// NewAPIKey creates a new API key and returns it together with the plaintext key.
+func NewAPIKey(name, owner string, scopes []string, expiresAt *time.Time) (*APIKey, string, error) {
+	if name == "" {
+		return nil, "", errors.NewFieldError("name", errors.MsgRequired)
+	}
+	if owner == "" {
+		return nil, "", errors.NewFieldError("owner", errors.MsgRequired)
+	}
+	if len(scopes) == 0 {
+		return nil, "", errors.NewFieldError("scopes", errors.MsgNotEmpty)
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(Scopes, scope) {
+			return nil, "", errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgInvalidScope, scope).
+				WithField("scopes", errors.MsgUnknownScope, scope)
+		}
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", errors.NewFieldError("expires_at", errors.MsgFutureDate)
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", errors.NewErrorWithArgs(errors.InternalError, "failed to generate API key: %v", err)
+	}
+
+	id := uuid.New()
+	prefix := keyPrefix + strings.ReplaceAll(id.String(), "-", "")[:8]
+	plaintext := prefix + "_" + base64.RawURLEncoding.EncodeToString(secret)
+
+	return &APIKey{
+		ID:        id,
+		Name:      name,
+		Owner:     owner,
+		Prefix:    prefix,
+		Hash:      HashKey(plaintext),
+		Scopes:    slices.Clone(scopes),
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}, plaintext, nil
+}
+
+// HashKey returns the stored form of a plaintext API key.
+func HashKey(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
+
+// HasScope reports whether the key grants the scope.
+func (k *APIKey) HasScope(scope string) bool {
+	return slices.Contains(k.Scopes, scope)
+}
+
+// Active reports whether the key can be used at the given time.
+func (k *APIKey) Active(now time.Time) bool {
+	if k.RevokedAt != nil && !k.RevokedAt.After(now) {
+		return false
+	}
+	return k.ExpiresAt == nil || k.ExpiresAt.After(now)
+}
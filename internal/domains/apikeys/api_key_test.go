@@ -0,0 +1,54 @@
+package apikey
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		k, plaintext, err := NewAPIKey("partner", "acme", []string{ScopePlayersRead}, nil)
+
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(plaintext, k.Prefix+"_"))
+		assert.Equal(t, HashKey(plaintext), k.Hash)
+		assert.NotContains(t, k.Hash, plaintext)
+		assert.True(t, k.HasScope(ScopePlayersRead))
+		assert.False(t, k.HasScope(ScopePlayersWrite))
+	})
+
+	t.Run("unknown scope", func(t *testing.T) {
+		_, _, err := NewAPIKey("partner", "acme", []string{"players:delete"}, nil)
+
+		var customErr *customErrors.Error
+		if assert.ErrorAs(t, err, &customErr) {
+			assert.Equal(t, customErrors.InvalidArgumentError, customErr.Code)
+			assert.Equal(t, "Invalid scope: players:delete", customErr.Message)
+		}
+	})
+
+	t.Run("expiry in the past", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour)
+		_, _, err := NewAPIKey("partner", "acme", []string{ScopePlayersRead}, &past)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAPIKey_Active(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Hour)
+
+	assert.True(t, (&APIKey{}).Active(now))
+	assert.True(t, (&APIKey{ExpiresAt: &later}).Active(now))
+	assert.False(t, (&APIKey{ExpiresAt: &earlier}).Active(now))
+	assert.False(t, (&APIKey{RevokedAt: &earlier}).Active(now))
+	// 교체 유예 기간 동안에는 아직 사용 가능
+	assert.True(t, (&APIKey{RevokedAt: &later}).Active(now))
+}
@@ -0,0 +1,112 @@
+package player
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/romanize"
+)
+
+// Kinds of alternate player names.
+const (
+	AliasTypeLegal      = "legal"
+	AliasTypeRomanized  = "romanized"
+	AliasTypeNickname   = "nickname"
+	AliasTypeFormerName = "former_name"
+)
+
+var aliasTypes = []string{AliasTypeLegal, AliasTypeRomanized, AliasTypeNickname, AliasTypeFormerName}
+
+// LocaleKoreanLatin is the locale of romanized Korean names.
+const LocaleKoreanLatin = "ko-Latn"
+
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// maxAliasLength is the longest alias accepted.
+const maxAliasLength = 200
+
+// Alias is another name a player is known by, such as a romanization or a nickname.
+// At most one alias per locale is primary, the name to display in that locale.
+type Alias struct {
+	ID       uuid.UUID `json:"id" db:"id"`
+	PlayerID uuid.UUID `json:"-" db:"player_id"`
+	Name     string    `json:"name" db:"name"`
+	Type     string    `json:"type" db:"type"`
+	// Locale is a BCP 47 language tag such as ko, en or ko-Latn; empty when unknown.
+	Locale  string `json:"locale,omitempty" db:"locale"`
+	Primary bool   `json:"primary" db:"is_primary"`
+}
+
+// NewAlias creates a validated alias.
+func NewAlias(name, aliasType, locale string, primary bool) (*Alias, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxAliasLength {
+		return nil, errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgInvalidAliasName)
+	}
+	if !slices.Contains(aliasTypes, aliasType) {
+		return nil, errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgInvalidAliasType, aliasType)
+	}
+	if locale != "" && !localePattern.MatchString(locale) {
+		return nil, errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgInvalidAliasLocale, locale)
+	}
+	if primary && locale == "" {
+		return nil, errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgPrimaryAliasLocale)
+	}
+
+	return &Alias{ID: uuid.New(), Name: name, Type: aliasType, Locale: locale, Primary: primary}, nil
+}
+
+// ValidateAliases checks that a player has at most one primary alias per locale and no alias twice.
+func ValidateAliases(aliases []*Alias) error {
+	primary := make(map[string]bool)
+	seen := make(map[string]bool, len(aliases))
+	for _, a := range aliases {
+		if a.Primary {
+			if primary[a.Locale] {
+				return errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgMultiplePrimaryAlias, a.Locale)
+			}
+			primary[a.Locale] = true
+		}
+
+		key := NormalizeName(a.Name) + "\x00" + a.Locale
+		if seen[key] {
+			return errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgDuplicateAlias, a.Name)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// AddRomanizedAliases appends the Revised Romanization of a Hangul name to aliases, along with
+// the customary spelling of the family name when it differs. Romanizations already present are skipped,
+// and names that are not Hangul leave aliases unchanged.
+func AddRomanizedAliases(aliases []*Alias, name string) []*Alias {
+	strict, ok := romanize.Name(name)
+	if !ok {
+		return aliases
+	}
+	customary, _ := romanize.CustomaryName(name)
+
+	for _, romanized := range []string{strict, customary} {
+		exists := slices.ContainsFunc(aliases, func(a *Alias) bool {
+			return a.Locale == LocaleKoreanLatin && NormalizeName(a.Name) == NormalizeName(romanized)
+		})
+		if !exists {
+			aliases = append(aliases, &Alias{ID: uuid.New(), Name: romanized, Type: AliasTypeRomanized, Locale: LocaleKoreanLatin})
+		}
+	}
+	return aliases
+}
+
+// names returns the player's name followed by its aliases.
+func (p *Player) names() []string {
+	names := make([]string, 0, len(p.Aliases)+1)
+	names = append(names, p.Name)
+	for _, a := range p.Aliases {
+		names = append(names, a.Name)
+	}
+	return names
+}
@@ -0,0 +1,58 @@
+package player
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAlias(t *testing.T) {
+	a, err := NewAlias(" 도니 ", AliasTypeNickname, "ko", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "도니", a.Name)
+
+	_, err = NewAlias("Doni", "stage_name", "en", false)
+	assert.Error(t, err)
+
+	_, err = NewAlias("Doni", AliasTypeNickname, "english", false)
+	assert.Error(t, err)
+
+	// 대표 별칭은 언어별로 지정되므로 로케일이 필요
+	_, err = NewAlias("Doni", AliasTypeNickname, "", true)
+	assert.Error(t, err)
+}
+
+func TestValidateAliases(t *testing.T) {
+	assert.NoError(t, ValidateAliases([]*Alias{
+		{Name: "Kim Do-yeong", Type: AliasTypeRomanized, Locale: "en", Primary: true},
+		{Name: "김도영", Type: AliasTypeLegal, Locale: "ko", Primary: true},
+	}))
+
+	assert.Error(t, ValidateAliases([]*Alias{
+		{Name: "Kim Do-yeong", Type: AliasTypeRomanized, Locale: "en", Primary: true},
+		{Name: "Kim Do-young", Type: AliasTypeRomanized, Locale: "en", Primary: true},
+	}))
+
+	assert.Error(t, ValidateAliases([]*Alias{
+		{Name: "Kim Do-yeong", Type: AliasTypeRomanized, Locale: "en"},
+		{Name: "kim doyeong", Type: AliasTypeFormerName, Locale: "en"},
+	}))
+}
+
+func TestAddRomanizedAliases(t *testing.T) {
+	aliases := AddRomanizedAliases(nil, "김도영")
+	if assert.Len(t, aliases, 2) {
+		assert.Equal(t, "Gim Do-yeong", aliases[0].Name)
+		assert.Equal(t, "Kim Do-yeong", aliases[1].Name)
+		assert.Equal(t, AliasTypeRomanized, aliases[1].Type)
+		assert.Equal(t, LocaleKoreanLatin, aliases[1].Locale)
+	}
+
+	// 이미 있는 표기는 다시 추가하지 않음
+	assert.Len(t, AddRomanizedAliases(aliases, "김도영"), 2)
+
+	// 관용 표기가 같으면 하나만 추가
+	assert.Len(t, AddRomanizedAliases(nil, "양현종"), 1)
+
+	assert.Empty(t, AddRomanizedAliases(nil, "Kim Do-young"))
+}
@@ -0,0 +1,24 @@
+package player
+
+// BatchMode controls how a batch write treats failing items.
+type BatchMode string
+
+const (
+	// BatchModeAtomic stores every item or none of them.
+	BatchModeAtomic BatchMode = "atomic"
+	// BatchModePartial stores the valid items and reports the failing ones.
+	BatchModePartial BatchMode = "partial"
+)
+
+// BatchItemStatus is the outcome of a single item in a batch write.
+type BatchItemStatus string
+
+const (
+	BatchItemCreated  BatchItemStatus = "created"
+	BatchItemUpdated  BatchItemStatus = "updated"
+	BatchItemConflict BatchItemStatus = "conflict"
+	BatchItemInvalid  BatchItemStatus = "invalid"
+)
+
+// MaxBatchSize is the maximum number of players accepted in a single batch write.
+const MaxBatchSize = 500
@@ -0,0 +1,170 @@
+package player
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DefaultDuplicateScore is the lowest similarity reported as a likely duplicate by default.
+const DefaultDuplicateScore = 0.8
+
+// Reasons a pair of players is considered similar.
+const (
+	DuplicateReasonName      = "name"
+	DuplicateReasonTeam      = "team"
+	DuplicateReasonBirthDate = "birth_date"
+)
+
+// DuplicateCandidate is a pair of players that are likely the same person.
+type DuplicateCandidate struct {
+	Player    *Player  `json:"player"`
+	Duplicate *Player  `json:"duplicate"`
+	Score     float64  `json:"score"`
+	Reasons   []string `json:"reasons"`
+}
+
+// NormalizeName reduces a name to its lower-case letters and digits, so that spacing,
+// punctuation and case differences between sources do not matter.
+func NormalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Similarity scores how likely two players are the same person, between 0 and 1.
+// Players of different sports never match. The normalized name weighs 60%, the team 20% and
+// the birth date 20%; an unknown birth date counts half, and different birth dates halve the score.
+// Names are compared across aliases, and the closest pair counts.
+func Similarity(a, b *Player) (float64, []string) {
+	if !strings.EqualFold(strings.TrimSpace(a.Sport), strings.TrimSpace(b.Sport)) {
+		return 0, nil
+	}
+
+	var reasons []string
+
+	var nameScore float64
+	for _, an := range a.names() {
+		for _, bn := range b.names() {
+			nameScore = max(nameScore, nameSimilarity(NormalizeName(an), NormalizeName(bn)))
+		}
+	}
+	if nameScore >= DefaultDuplicateScore {
+		reasons = append(reasons, DuplicateReasonName)
+	}
+	score := 0.6 * nameScore
+
+	if NormalizeName(a.Team) == NormalizeName(b.Team) {
+		score += 0.2
+		reasons = append(reasons, DuplicateReasonTeam)
+	}
+
+	switch {
+	case a.BirthDate == nil || b.BirthDate == nil:
+		score += 0.1
+	case a.BirthDate.Equal(*b.BirthDate):
+		score += 0.2
+		reasons = append(reasons, DuplicateReasonBirthDate)
+	default:
+		score /= 2
+	}
+
+	return score, reasons
+}
+
+// FindDuplicates returns the pairs of players scoring at least minScore, best first.
+// Only players of the same sport with names or aliases sharing their first two normalized characters
+// are compared, which keeps the search fast on large rosters.
+func FindDuplicates(players []*Player, minScore float64) []*DuplicateCandidate {
+	blocks := make(map[string][]*Player)
+	for _, p := range players {
+		keys := make(map[string]bool)
+		for _, name := range p.names() {
+			key := strings.ToLower(strings.TrimSpace(p.Sport)) + "\x00" + namePrefix(NormalizeName(name), 2)
+			if !keys[key] {
+				keys[key] = true
+				blocks[key] = append(blocks[key], p)
+			}
+		}
+	}
+
+	// 별칭 때문에 같은 쌍이 여러 블록에 나타날 수 있음
+	compared := make(map[[2]*Player]bool)
+	candidates := []*DuplicateCandidate{}
+	for _, block := range blocks {
+		for i := 0; i < len(block); i++ {
+			for j := i + 1; j < len(block); j++ {
+				pair := [2]*Player{block[i], block[j]}
+				if compared[pair] || compared[[2]*Player{block[j], block[i]}] {
+					continue
+				}
+				compared[pair] = true
+
+				score, reasons := Similarity(block[i], block[j])
+				if score < minScore {
+					continue
+				}
+				// 먼저 생성된 선수를 기준으로 표시
+				first, second := block[i], block[j]
+				if second.CreatedAt.Before(first.CreatedAt) {
+					first, second = second, first
+				}
+				candidates = append(candidates, &DuplicateCandidate{Player: first, Duplicate: second, Score: score, Reasons: reasons})
+			}
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].Player.ID.String() < candidates[j].Player.ID.String()
+	})
+
+	return candidates
+}
+
+// nameSimilarity is one minus the edit distance between two names relative to the longer one.
+func nameSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 0
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein computes the edit distance between two rune slices.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func namePrefix(name string, n int) string {
+	r := []rune(name)
+	if len(r) > n {
+		r = r[:n]
+	}
+	return string(r)
+}
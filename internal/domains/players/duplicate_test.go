@@ -0,0 +1,65 @@
+package player
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeName(t *testing.T) {
+	assert.Equal(t, "kimdoyoung", NormalizeName("Kim Do-Young"))
+	assert.Equal(t, "김도영", NormalizeName(" 김 도영 "))
+}
+
+func TestSimilarity(t *testing.T) {
+	birth := time.Date(2003, 10, 2, 0, 0, 0, 0, time.UTC)
+	other := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	a := &Player{Name: "Kim Do-young", Sport: "야구", Team: "기아", BirthDate: &birth}
+
+	score, reasons := Similarity(a, &Player{Name: "kim doyoung", Sport: "야구", Team: "기아", BirthDate: &birth})
+	assert.InDelta(t, 1.0, score, 0.001)
+	assert.Equal(t, []string{DuplicateReasonName, DuplicateReasonTeam, DuplicateReasonBirthDate}, reasons)
+
+	// 생년월일을 모르면 절반만 반영
+	score, _ = Similarity(a, &Player{Name: "Kim Doyoung", Sport: "야구", Team: "기아"})
+	assert.InDelta(t, 0.9, score, 0.001)
+
+	// 생년월일이 다르면 동명이인일 가능성이 높음
+	score, _ = Similarity(a, &Player{Name: "Kim Doyoung", Sport: "야구", Team: "기아", BirthDate: &other})
+	assert.Less(t, score, DefaultDuplicateScore)
+
+	score, _ = Similarity(a, &Player{Name: "Kim Doyoung", Sport: "축구", Team: "기아", BirthDate: &birth})
+	assert.Equal(t, 0.0, score)
+}
+
+func TestFindDuplicates(t *testing.T) {
+	now := time.Now()
+	original := &Player{ID: uuid.New(), Name: "김도영", Sport: "야구", Team: "기아", CreatedAt: now.Add(-time.Hour)}
+	imported := &Player{ID: uuid.New(), Name: "김 도영", Sport: "야구", Team: "기아", CreatedAt: now}
+	unrelated := &Player{ID: uuid.New(), Name: "양현종", Sport: "야구", Team: "기아", CreatedAt: now}
+
+	candidates := FindDuplicates([]*Player{imported, unrelated, original}, DefaultDuplicateScore)
+
+	if assert.Len(t, candidates, 1) {
+		assert.Equal(t, original.ID, candidates[0].Player.ID)
+		assert.Equal(t, imported.ID, candidates[0].Duplicate.ID)
+		assert.InDelta(t, 0.9, candidates[0].Score, 0.001)
+	}
+}
+
+func TestFindDuplicates_Aliases(t *testing.T) {
+	now := time.Now()
+	hangul := &Player{ID: uuid.New(), Name: "김도영", Sport: "야구", Team: "기아", CreatedAt: now.Add(-time.Hour),
+		Aliases: []*Alias{{Name: "Kim Do-yeong", Type: AliasTypeRomanized, Locale: LocaleKoreanLatin}}}
+	latin := &Player{ID: uuid.New(), Name: "Kim Do Yeong", Sport: "야구", Team: "기아", CreatedAt: now}
+
+	// 로마자 별칭을 통해 한글 이름과 영문 이름이 비교됨
+	candidates := FindDuplicates([]*Player{hangul, latin}, DefaultDuplicateScore)
+	if assert.Len(t, candidates, 1) {
+		assert.Equal(t, hangul, candidates[0].Player)
+		assert.Contains(t, candidates[0].Reasons, DuplicateReasonName)
+	}
+}
@@ -0,0 +1,60 @@
+package player
+
+import (
+	"regexp"
+
+	"github.com/google/uuid"
+	"player_management_system/internal/pkg/errors"
+)
+
+// Well-known external identifier namespaces. Other namespaces, such as a data provider name, are allowed.
+// NamespaceDefault holds the external_id of players, which batch upserts and roster imports match on.
+const (
+	NamespaceDefault  = "default"
+	NamespaceLeague   = "league"
+	NamespaceWikidata = "wikidata"
+)
+
+var (
+	namespacePattern   = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]{0,63}$`)
+	wikidataQIDPattern = regexp.MustCompile(`^Q[1-9][0-9]*$`)
+)
+
+// maxExternalIDValueLength is the longest external identifier value accepted.
+const maxExternalIDValueLength = 255
+
+// ExternalIdentifier links a player to its ID in another system.
+// A (namespace, value) pair identifies at most one player.
+type ExternalIdentifier struct {
+	PlayerID  uuid.UUID `json:"-" db:"player_id"`
+	Namespace string    `json:"namespace" db:"namespace"`
+	Value     string    `json:"value" db:"value"`
+}
+
+// NewExternalIdentifier creates a validated external identifier.
+// Namespaces are lower-case; Wikidata identifiers must be QIDs such as Q42.
+func NewExternalIdentifier(namespace, value string) (*ExternalIdentifier, error) {
+	if !namespacePattern.MatchString(namespace) {
+		return nil, errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgInvalidExternalIDNamespace, namespace)
+	}
+	if value == "" || len(value) > maxExternalIDValueLength {
+		return nil, errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgInvalidExternalIDValue, namespace)
+	}
+	if namespace == NamespaceWikidata && !wikidataQIDPattern.MatchString(value) {
+		return nil, errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgInvalidWikidataQID, value)
+	}
+
+	return &ExternalIdentifier{Namespace: namespace, Value: value}, nil
+}
+
+// ValidateExternalIdentifiers checks that a player has at most one identifier per namespace.
+func ValidateExternalIdentifiers(ids []*ExternalIdentifier) error {
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if seen[id.Namespace] {
+			return errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgDuplicateExternalIDNamespace, id.Namespace)
+		}
+		seen[id.Namespace] = true
+	}
+	return nil
+}
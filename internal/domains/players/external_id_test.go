@@ -0,0 +1,47 @@
+package player
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+func TestNewExternalIdentifier(t *testing.T) {
+	id, err := NewExternalIdentifier(NamespaceWikidata, "Q42")
+	assert.NoError(t, err)
+	assert.Equal(t, "Q42", id.Value)
+
+	_, err = NewExternalIdentifier("statiz", "12345")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		namespace string
+		value     string
+	}{
+		{"upper-case namespace", "League", "2021-001"},
+		{"empty namespace", "", "2021-001"},
+		{"empty value", NamespaceLeague, ""},
+		{"invalid QID", NamespaceWikidata, "42"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewExternalIdentifier(tt.namespace, tt.value)
+
+			var customErr *customErrors.Error
+			if assert.ErrorAs(t, err, &customErr) {
+				assert.Equal(t, customErrors.InvalidArgumentError, customErr.Code)
+			}
+		})
+	}
+}
+
+func TestValidateExternalIdentifiers(t *testing.T) {
+	league := &ExternalIdentifier{Namespace: NamespaceLeague, Value: "2021-001"}
+	wikidata := &ExternalIdentifier{Namespace: NamespaceWikidata, Value: "Q42"}
+
+	assert.NoError(t, ValidateExternalIdentifiers([]*ExternalIdentifier{league, wikidata}))
+	assert.Error(t, ValidateExternalIdentifiers([]*ExternalIdentifier{league, {Namespace: NamespaceLeague, Value: "2022-001"}}))
+}
@@ -0,0 +1,25 @@
+package player
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Injury statuses.
+const (
+	InjuryStatusDayToDay = "day_to_day"
+	InjuryStatusOut      = "out"
+)
+
+// Injury represents an injury record of a player.
+// An injury without ResolvedAt is still active.
+type Injury struct {
+	ID               uuid.UUID  `json:"id" db:"id"`
+	PlayerID         uuid.UUID  `json:"player_id" db:"player_id"`
+	Status           string     `json:"status" db:"status"`
+	Description      string     `json:"description" db:"description"`
+	InjuredAt        time.Time  `json:"injured_at" db:"injured_at"`
+	ExpectedReturnAt *time.Time `json:"expected_return_at,omitempty" db:"expected_return_at"`
+	ResolvedAt       *time.Time `json:"resolved_at,omitempty" db:"resolved_at"`
+}
@@ -8,26 +8,43 @@ import (
 )
 
 // Player represents a player entity.
+// ExternalID is the player's external identifier in NamespaceDefault.
 type Player struct {
-	ID              uuid.UUID `json:"id" db:"id"`
-	Name            string    `json:"name" db:"name"`
-	Sport           string    `json:"sport" db:"sport"`
-	Team            string    `json:"team" db:"team"`
-	ProfileImageURL string    `json:"profile_image_url" db:"profile_image_url"`
-	CreatedAt       time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+	ID              uuid.UUID  `json:"id" db:"id"`
+	Name            string     `json:"name" db:"name"`
+	Sport           string     `json:"sport" db:"sport"`
+	Team            string     `json:"team" db:"team"`
+	ProfileImageURL string     `json:"profile_image_url" db:"profile_image_url"`
+	ExternalID      string     `json:"external_id,omitempty" db:"external_id"`
+	BirthDate       *time.Time `json:"birth_date,omitempty" db:"birth_date"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
+
+	// Relations, only populated when expanded.
+	Descriptions []*PlayerDescription `json:"descriptions,omitempty" db:"-"`
+	Media        []*Media             `json:"media,omitempty" db:"-"`
+
+	// ExternalIDs cross-reference the player in other systems. They are loaded when expanded,
+	// and replaced on create and update unless nil.
+	ExternalIDs []*ExternalIdentifier `json:"external_ids,omitempty" db:"-"`
+	// Aliases are the other names of the player. Like external IDs, they are loaded when expanded,
+	// and replaced on create and update unless nil.
+	Aliases []*Alias `json:"aliases,omitempty" db:"-"`
 }
 
 // NewPlayer creates a new Player entity.
 func NewPlayer(name, sport, team, profileImageURL string) (*Player, error) {
 	if name == "" {
-		return nil, errors.NewErrorWithArgs(errors.InvalidArgumentError, "Invalid argument: %s", "name")
+		return nil, errors.NewFieldError("name", errors.MsgRequired)
 	}
 	if sport == "" {
-		return nil, errors.NewErrorWithArgs(errors.InvalidArgumentError, "Invalid argument: %s", "sport")
+		return nil, errors.NewFieldError("sport", errors.MsgRequired)
 	}
 	if team == "" {
-		return nil, errors.NewErrorWithArgs(errors.InvalidArgumentError, "Invalid argument: %s", "team")
+		return nil, errors.NewFieldError("team", errors.MsgRequired)
+	}
+	if err := ValidateProfileImageURL(profileImageURL); err != nil {
+		return nil, err
 	}
 
 	return &Player{
@@ -40,3 +57,18 @@ func NewPlayer(name, sport, team, profileImageURL string) (*Player, error) {
 		UpdatedAt:       time.Now(),
 	}, nil
 }
+
+// BirthDateLayout is the format of birth dates in requests and exports.
+const BirthDateLayout = "2006-01-02"
+
+// ParseBirthDate parses a birth date written as YYYY-MM-DD. An empty string means the birth date is unknown.
+func ParseBirthDate(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(BirthDateLayout, s)
+	if err != nil || t.After(time.Now()) {
+		return nil, errors.NewFieldError("birth_date", errors.MsgPastDate)
+	}
+	return &t, nil
+}
@@ -0,0 +1,9 @@
+package player
+
+// PlayerFilter narrows a player listing. Empty fields do not filter.
+type PlayerFilter struct {
+	// Name matches players whose name contains it, ignoring case.
+	Name  string
+	Sport string
+	Team  string
+}
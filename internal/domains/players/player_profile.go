@@ -0,0 +1,12 @@
+package player
+
+// PlayerProfile aggregates everything a profile page needs about a player.
+// Optional parts are nil (or empty) when no data exists for them.
+type PlayerProfile struct {
+	Player            *Player            `json:"player"`
+	Team              *Team              `json:"team"`
+	LatestDescription *PlayerDescription `json:"latest_description"`
+	RecentMedia       []*Media           `json:"recent_media"`
+	SeasonStats       *SeasonStats       `json:"season_stats"`
+	Injury            *Injury            `json:"injury"`
+}
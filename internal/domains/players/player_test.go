@@ -61,4 +61,61 @@ func TestNewPlayer(t *testing.T) {
 			assert.Equal(t, "Invalid argument: team", customErr.Message)
 		}
 	})
+
+	t.Run("invalid profile image URL", func(t *testing.T) {
+		for _, url := range []string{"ftp://example.com/image.jpg", "example.com/image.jpg", "//example.com/image.jpg"} {
+			_, err := NewPlayer("김도영", "야구", "기아", url)
+
+			var customErr *customErrors.Error
+			if assert.ErrorAs(t, err, &customErr, url) {
+				assert.Equal(t, customErrors.InvalidArgumentError, customErr.Code)
+			}
+		}
+
+		// 직접 제공하는 이미지의 경로와 빈 URL은 허용
+		for _, url := range []string{"", "/images/players/1/original.jpg"} {
+			_, err := NewPlayer("김도영", "야구", "기아", url)
+			assert.NoError(t, err, url)
+		}
+	})
+}
+
+func TestParseReadOptions(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		opts, err := ParseReadOptions("", "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, opts.Fields)
+		assert.True(t, opts.Selects("name"))
+		assert.False(t, opts.Expands(ExpandMedia))
+	})
+
+	t.Run("fields and expand", func(t *testing.T) {
+		opts, err := ParseReadOptions(" id,name , name", "descriptions,media")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"id", "name"}, opts.Fields)
+		assert.True(t, opts.Selects("name"))
+		assert.False(t, opts.Selects("team"))
+		assert.True(t, opts.Expands(ExpandDescriptions))
+		assert.True(t, opts.Expands(ExpandMedia))
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := ParseReadOptions("id,password", "")
+
+		var customErr *customErrors.Error
+		if assert.ErrorAs(t, err, &customErr) {
+			assert.Equal(t, customErrors.InvalidArgumentError, customErr.Code)
+		}
+	})
+
+	t.Run("unknown relation", func(t *testing.T) {
+		_, err := ParseReadOptions("", "stats")
+
+		var customErr *customErrors.Error
+		if assert.ErrorAs(t, err, &customErr) {
+			assert.Equal(t, customErrors.InvalidArgumentError, customErr.Code)
+		}
+	})
 }
@@ -0,0 +1,50 @@
+package player
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/safehttp"
+)
+
+// ProfileImage describes a stored profile image and its thumbnails.
+type ProfileImage struct {
+	PlayerID    uuid.UUID                `json:"player_id"`
+	URL         string                   `json:"url"`
+	ContentType string                   `json:"content_type"`
+	Width       int                      `json:"width"`
+	Height      int                      `json:"height"`
+	Thumbnails  []*ProfileImageThumbnail `json:"thumbnails"`
+}
+
+// ProfileImageThumbnail is a square rendition of a profile image.
+type ProfileImageThumbnail struct {
+	Size int    `json:"size"`
+	URL  string `json:"url"`
+}
+
+// ValidateProfileImageURL checks that a profile image URL is an absolute http or https URL. An empty URL
+// means the player has no profile image, and a root-relative path points to an image we serve ourselves.
+// Whether the host may be fetched is checked separately, when the URL is submitted and when it is fetched.
+func ValidateProfileImageURL(raw string) error {
+	if raw == "" || (strings.HasPrefix(raw, "/") && !strings.HasPrefix(raw, "//") && len(raw) <= safehttp.MaxURLLength) {
+		return nil
+	}
+	if _, err := safehttp.ParseURL(raw); err != nil {
+		return errors.NewFieldError("profile_image_url", errors.MsgInvalidURL, safehttp.MaxURLLength)
+	}
+	return nil
+}
+
+// ProfileImageLinkCheck is the outcome of checking that a player's remote profile image URL still serves an image.
+type ProfileImageLinkCheck struct {
+	PlayerID uuid.UUID `json:"player_id" db:"player_id"`
+	URL      string    `json:"url" db:"url"`
+	// StatusCode is the HTTP status of the response, or zero when no response was received.
+	StatusCode int       `json:"status_code,omitempty" db:"status_code"`
+	Error      string    `json:"error,omitempty" db:"error"`
+	Broken     bool      `json:"broken" db:"broken"`
+	CheckedAt  time.Time `json:"checked_at" db:"checked_at"`
+}
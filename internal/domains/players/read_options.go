@@ -0,0 +1,83 @@
+package player
+
+import (
+	"slices"
+	"strings"
+
+	"player_management_system/internal/pkg/errors"
+)
+
+// PlayerFields lists the player fields that can be requested through a sparse fieldset.
+var PlayerFields = []string{"id", "name", "sport", "team", "profile_image_url", "external_id", "birth_date", "created_at", "updated_at"}
+
+// Relations that can be embedded into a player read.
+const (
+	ExpandDescriptions = "descriptions"
+	ExpandMedia        = "media"
+	ExpandExternalIDs  = "external_ids"
+	ExpandAliases      = "aliases"
+)
+
+var expandableRelations = []string{ExpandDescriptions, ExpandMedia, ExpandExternalIDs, ExpandAliases}
+
+// Limits of player listings.
+const (
+	// DefaultPageSize is the page size used when none is requested.
+	DefaultPageSize = 10
+	// MaxPageSize is the largest page returned; larger requests get a page of this size.
+	MaxPageSize = 100
+	// MaxExpandedMedia is the number of most recent media embedded per player by ?expand=media.
+	MaxExpandedMedia = 20
+)
+
+// ReadOptions controls which fields are selected and which relations are embedded when reading players.
+// An empty Fields selects every field.
+type ReadOptions struct {
+	Fields []string
+	Expand []string
+}
+
+// ParseReadOptions parses comma separated fields and expand lists, rejecting unknown names.
+func ParseReadOptions(fields, expand string) (ReadOptions, error) {
+	var opts ReadOptions
+
+	for _, f := range splitList(fields) {
+		if !slices.Contains(PlayerFields, f) {
+			return ReadOptions{}, errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgInvalidFields, f)
+		}
+		if !slices.Contains(opts.Fields, f) {
+			opts.Fields = append(opts.Fields, f)
+		}
+	}
+
+	for _, rel := range splitList(expand) {
+		if !slices.Contains(expandableRelations, rel) {
+			return ReadOptions{}, errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgInvalidExpand, rel)
+		}
+		if !slices.Contains(opts.Expand, rel) {
+			opts.Expand = append(opts.Expand, rel)
+		}
+	}
+
+	return opts, nil
+}
+
+// Expands reports whether the given relation should be embedded.
+func (o ReadOptions) Expands(relation string) bool {
+	return slices.Contains(o.Expand, relation)
+}
+
+// Selects reports whether the given field should be returned.
+func (o ReadOptions) Selects(field string) bool {
+	return len(o.Fields) == 0 || slices.Contains(o.Fields, field)
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,18 @@
+package player
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SeasonStats represents a player's statistics for a single season.
+// Stats holds the sport-specific figures as a JSON object.
+type SeasonStats struct {
+	PlayerID    uuid.UUID       `json:"player_id" db:"player_id"`
+	Season      int             `json:"season" db:"season"`
+	GamesPlayed int             `json:"games_played" db:"games_played"`
+	Stats       json.RawMessage `json:"stats" db:"stats"`
+	UpdatedAt   time.Time       `json:"updated_at" db:"updated_at"`
+}
@@ -0,0 +1,19 @@
+package player
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Team represents the team a player belongs to.
+type Team struct {
+	ID        uuid.UUID `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	Sport     string    `json:"sport" db:"sport"`
+	City      string    `json:"city" db:"city"`
+	Stadium   string    `json:"stadium" db:"stadium"`
+	LogoURL   string    `json:"logo_url" db:"logo_url"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
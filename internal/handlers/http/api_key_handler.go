@@ -0,0 +1,109 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	apiKeyDomain "player_management_system/internal/domains/apikeys"
+	customErrors "player_management_system/internal/pkg/errors"
+	apiKeyService "player_management_system/internal/services/apikey"
+)
+
+// APIKeyHandler handles the admin HTTP requests for API keys.
+type APIKeyHandler struct {
+	apiKeyService apiKeyService.APIKeyService
+}
+
+// NewAPIKeyHandler creates a new APIKeyHandler.
+func NewAPIKeyHandler(apiKeyService apiKeyService.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{apiKeyService: apiKeyService}
+}
+
+// RegisterRoutes registers the API key routes with the Echo router.
+func (h *APIKeyHandler) RegisterRoutes(e *echo.Echo) {
+	e.POST("/api-keys", h.IssueAPIKey)
+	e.GET("/api-keys", h.GetAPIKeys)
+	e.POST("/api-keys/:id/rotate", h.RotateAPIKey)
+	e.DELETE("/api-keys/:id", h.RevokeAPIKey)
+}
+
+// IssueAPIKeyRequest represents the request body for issuing an API key.
+type IssueAPIKeyRequest struct {
+	Name      string     `json:"name" validate:"required,max=100"`
+	Owner     string     `json:"owner" validate:"required,max=100"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1,dive,required"`
+	ExpiresAt *time.Time `json:"expires_at" validate:"omitempty,future"`
+}
+
+// RotateAPIKeyRequest represents the request body for rotating an API key.
+type RotateAPIKeyRequest struct {
+	// GraceSeconds is how long the old key keeps working, at most a week (MaxRotationGrace).
+	GraceSeconds int `json:"grace_seconds" validate:"gte=0,lte=604800"`
+}
+
+// IssuedAPIKeyResponse carries a newly issued API key. The plaintext key is only ever returned here.
+type IssuedAPIKeyResponse struct {
+	*apiKeyDomain.APIKey
+	Key string `json:"key"`
+}
+
+// IssueAPIKey handles the POST /api-keys request.
+func (h *APIKeyHandler) IssueAPIKey(c echo.Context) error {
+	var req IssueAPIKeyRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	k, plaintext, err := h.apiKeyService.IssueAPIKey(c.Request().Context(), req.Name, req.Owner, req.Scopes, req.ExpiresAt)
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	return c.JSON(http.StatusCreated, IssuedAPIKeyResponse{APIKey: k, Key: plaintext})
+}
+
+// GetAPIKeys handles the GET /api-keys request.
+func (h *APIKeyHandler) GetAPIKeys(c echo.Context) error {
+	keys, err := h.apiKeyService.GetAPIKeys(c.Request().Context())
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	return c.JSON(http.StatusOK, keys)
+}
+
+// RotateAPIKey handles the POST /api-keys/:id/rotate request.
+func (h *APIKeyHandler) RotateAPIKey(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return customErrors.NewHTTPError(customErrors.NewFieldError("id", customErrors.MsgInvalidUUID))
+	}
+
+	var req RotateAPIKeyRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	k, plaintext, err := h.apiKeyService.RotateAPIKey(c.Request().Context(), id, time.Duration(req.GraceSeconds)*time.Second)
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	return c.JSON(http.StatusCreated, IssuedAPIKeyResponse{APIKey: k, Key: plaintext})
+}
+
+// RevokeAPIKey handles the DELETE /api-keys/:id request.
+func (h *APIKeyHandler) RevokeAPIKey(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return customErrors.NewHTTPError(customErrors.NewFieldError("id", customErrors.MsgInvalidUUID))
+	}
+
+	if err := h.apiKeyService.RevokeAPIKey(c.Request().Context(), id); err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
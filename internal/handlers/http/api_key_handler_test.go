@@ -0,0 +1,132 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	apiKeyDomain "player_management_system/internal/domains/apikeys"
+	"player_management_system/internal/pkg/auth"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+// MockAPIKeyService is a mock implementation of apiKeyService.APIKeyService
+type MockAPIKeyService struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyService) IssueAPIKey(ctx context.Context, name, owner string, scopes []string, expiresAt *time.Time) (*apiKeyDomain.APIKey, string, error) {
+	args := m.Called(ctx, name, owner, scopes, expiresAt)
+	k, _ := args.Get(0).(*apiKeyDomain.APIKey)
+	return k, args.String(1), args.Error(2)
+}
+
+func (m *MockAPIKeyService) GetAPIKeys(ctx context.Context) ([]*apiKeyDomain.APIKey, error) {
+	args := m.Called(ctx)
+	keys, _ := args.Get(0).([]*apiKeyDomain.APIKey)
+	return keys, args.Error(1)
+}
+
+func (m *MockAPIKeyService) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyService) RotateAPIKey(ctx context.Context, id uuid.UUID, grace time.Duration) (*apiKeyDomain.APIKey, string, error) {
+	args := m.Called(ctx, id, grace)
+	k, _ := args.Get(0).(*apiKeyDomain.APIKey)
+	return k, args.String(1), args.Error(2)
+}
+
+func (m *MockAPIKeyService) Authenticate(ctx context.Context, plaintext string) (*auth.Principal, error) {
+	args := m.Called(ctx, plaintext)
+	p, _ := args.Get(0).(*auth.Principal)
+	return p, args.Error(1)
+}
+
+func TestIssueAPIKey(t *testing.T) {
+	e := newTestEcho()
+	mockService := new(MockAPIKeyService)
+	handler := NewAPIKeyHandler(mockService)
+
+	k := &apiKeyDomain.APIKey{ID: uuid.New(), Name: "partner", Owner: "acme", Prefix: "pms_12345678", Hash: "secret-hash", Scopes: []string{"players:read"}}
+	mockService.On("IssueAPIKey", mock.Anything, "partner", "acme", []string{"players:read"}, (*time.Time)(nil)).
+		Return(k, "pms_12345678_secret", nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api-keys", strings.NewReader(`{"name":"partner","owner":"acme","scopes":["players:read"]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// 실행
+	err := handler.IssueAPIKey(c)
+
+	// 검증
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "pms_12345678_secret", body["key"])
+	assert.Equal(t, "acme", body["owner"])
+	assert.NotContains(t, rec.Body.String(), "secret-hash")
+	mockService.AssertExpectations(t)
+}
+
+func TestRotateAPIKey(t *testing.T) {
+	e := newTestEcho()
+	mockService := new(MockAPIKeyService)
+	handler := NewAPIKeyHandler(mockService)
+
+	id := uuid.New()
+	k := &apiKeyDomain.APIKey{ID: uuid.New(), Name: "partner", Owner: "acme"}
+	mockService.On("RotateAPIKey", mock.Anything, id, time.Hour).Return(k, "pms_new", nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api-keys/"+id.String()+"/rotate", strings.NewReader(`{"grace_seconds":3600}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(id.String())
+
+	// 실행
+	err := handler.RotateAPIKey(c)
+
+	// 검증
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestRevokeAPIKey_Forbidden(t *testing.T) {
+	e := newTestEcho()
+	mockService := new(MockAPIKeyService)
+	handler := NewAPIKeyHandler(mockService)
+
+	id := uuid.New()
+	mockService.On("RevokeAPIKey", mock.Anything, id).Return(customErrors.NewError(customErrors.ForbiddenError, "admin role required"))
+
+	req := httptest.NewRequest(http.MethodDelete, "/api-keys/"+id.String(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(id.String())
+
+	// 실행
+	err := handler.RevokeAPIKey(c)
+
+	// 검증
+	httpErr, ok := err.(*echo.HTTPError)
+	if assert.True(t, ok) {
+		assert.Equal(t, http.StatusForbidden, httpErr.Code)
+	}
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"log/slog"
+
+	"github.com/labstack/echo/v4"
+	"player_management_system/internal/pkg/auth"
+	apiKeyService "player_management_system/internal/services/apikey"
+)
+
+// HeaderAPIKey is the request header carrying an API key.
+const HeaderAPIKey = "X-API-Key"
+
+// APIKeyAuth returns a middleware that authenticates requests carrying an X-API-Key header
+// and stores a principal acting on behalf of the key owner in the request context.
+// Requests without the header are passed on untouched; requests with an invalid key are rejected with 401.
+// Every request made with a key is logged together with the key and its owner.
+func APIKeyAuth(service apiKeyService.APIKeyService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(HeaderAPIKey)
+			if key == "" {
+				return next(c)
+			}
+
+			principal, err := service.Authenticate(c.Request().Context(), key)
+			if err != nil {
+				return unauthenticated(c, err)
+			}
+
+			ctx := auth.WithPrincipal(c.Request().Context(), principal)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			err = next(c)
+			status := c.Response().Status
+			if httpErr, ok := err.(*echo.HTTPError); ok {
+				status = httpErr.Code
+			}
+			slog.InfoContext(c.Request().Context(), "api key used",
+				"api_key_id", principal.APIKeyID, "api_key_name", principal.Name, "owner", principal.Subject,
+				"method", c.Request().Method, "route", c.Path(), "status", status)
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"player_management_system/internal/pkg/auth"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+func newAPIKeyTestServer(t *testing.T, service *MockAPIKeyService) *echo.Echo {
+	verifier, err := auth.NewJWTVerifier(auth.JWTConfig{HMACSecret: testJWTSecret})
+	assert.NoError(t, err)
+
+	e := echo.New()
+	e.Use(APIKeyAuth(service))
+	e.Use(JWTAuth(verifier))
+	e.GET("/whoami", func(c echo.Context) error {
+		principal, _ := auth.PrincipalFromContext(c.Request().Context())
+		return c.JSON(http.StatusOK, principal)
+	})
+	return e
+}
+
+func TestAPIKeyAuth_ValidKey(t *testing.T) {
+	mockService := new(MockAPIKeyService)
+	e := newAPIKeyTestServer(t, mockService)
+
+	principal := &auth.Principal{Subject: "acme", Name: "partner", APIKeyID: "key-1", Scopes: []string{"players:read"}}
+	mockService.On("Authenticate", mock.Anything, "pms_valid").Return(principal, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+	req.Header.Set(HeaderAPIKey, "pms_valid")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"sub":"acme","name":"partner","api_key_id":"key-1","scopes":["players:read"]}`, rec.Body.String())
+}
+
+func TestAPIKeyAuth_InvalidKey(t *testing.T) {
+	mockService := new(MockAPIKeyService)
+	e := newAPIKeyTestServer(t, mockService)
+
+	mockService.On("Authenticate", mock.Anything, "pms_revoked").
+		Return(nil, customErrors.NewError(customErrors.UnauthenticatedError, "API key is expired or revoked"))
+
+	req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+	req.Header.Set(HeaderAPIKey, "pms_revoked")
+	// 유효한 JWT가 있어도 잘못된 API 키는 거부됨
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+mintToken(t, "user-1", []string{"viewer"}, "", time.Hour))
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestAPIKeyAuth_FallsBackToJWT(t *testing.T) {
+	mockService := new(MockAPIKeyService)
+	e := newAPIKeyTestServer(t, mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+mintToken(t, "user-1", []string{"viewer"}, "", time.Hour))
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockService.AssertNotCalled(t, "Authenticate", mock.Anything, mock.Anything)
+}
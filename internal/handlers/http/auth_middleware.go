@@ -0,0 +1,54 @@
+package http
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"player_management_system/internal/pkg/auth"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+// JWTAuth returns a middleware that authenticates every request with a bearer JWT
+// and stores the resulting principal in the request context.
+// Requests without a valid token are rejected with 401, unless an earlier middleware
+// such as APIKeyAuth has already authenticated them or they are health probes or metrics scrapes.
+func JWTAuth(verifier *auth.JWTVerifier) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if _, ok := auth.PrincipalFromContext(c.Request().Context()); ok || isProbe(c) {
+				return next(c)
+			}
+
+			token, ok := bearerToken(c)
+			if !ok {
+				return unauthenticated(c, customErrors.NewError(customErrors.UnauthenticatedError, "missing bearer token"))
+			}
+
+			principal, err := verifier.Verify(token)
+			if err != nil {
+				return unauthenticated(c, err)
+			}
+
+			ctx := auth.WithPrincipal(c.Request().Context(), principal)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer <token>" header.
+func bearerToken(c echo.Context) (string, bool) {
+	header := c.Request().Header.Get(echo.HeaderAuthorization)
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+// unauthenticated writes a structured 401 response.
+func unauthenticated(c echo.Context, err error) error {
+	c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="api"`)
+	return customErrors.HandleHTTPError(c, err)
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"player_management_system/internal/pkg/auth"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+const testJWTSecret = "test-secret"
+
+// mintToken signs an HS256 token for tests.
+func mintToken(t *testing.T, subject string, roles []string, team string, ttl time.Duration) string {
+	claims := auth.Claims{
+		Roles: roles,
+		Team:  team,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testJWTSecret))
+	assert.NoError(t, err)
+	return token
+}
+
+func newJWTTestServer(t *testing.T) *echo.Echo {
+	verifier, err := auth.NewJWTVerifier(auth.JWTConfig{HMACSecret: testJWTSecret})
+	assert.NoError(t, err)
+
+	e := echo.New()
+	e.Use(JWTAuth(verifier))
+	e.GET("/whoami", func(c echo.Context) error {
+		principal, ok := auth.PrincipalFromContext(c.Request().Context())
+		if !ok {
+			return c.NoContent(http.StatusInternalServerError)
+		}
+		return c.JSON(http.StatusOK, principal)
+	})
+	return e
+}
+
+func TestJWTAuth_ValidToken(t *testing.T) {
+	e := newJWTTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+mintToken(t, "user-1", []string{"editor"}, "", time.Hour))
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"sub":"user-1","roles":["editor"]}`, rec.Body.String())
+}
+
+func TestJWTAuth_MissingToken(t *testing.T) {
+	e := newJWTTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, `Bearer realm="api"`, rec.Header().Get(echo.HeaderWWWAuthenticate))
+	assert.Equal(t, customErrors.MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+	assert.JSONEq(t, `{"type":"about:blank","title":"Unauthorized","status":401,"detail":"missing bearer token","instance":"/whoami","code":"Unauthenticated"}`, rec.Body.String())
+}
+
+func TestJWTAuth_ExpiredToken(t *testing.T) {
+	e := newJWTTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+mintToken(t, "user-1", nil, "", -time.Minute))
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"code":"Unauthenticated"`)
+}
@@ -0,0 +1,18 @@
+package http
+
+import (
+	"github.com/labstack/echo/v4"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+// bindAndValidate binds the request into req and checks it with the validator registered on Echo,
+// which reports every invalid field at once.
+func bindAndValidate(c echo.Context, req interface{}) error {
+	if err := c.Bind(req); err != nil {
+		return customErrors.NewHTTPError(customErrors.NewErrorWithKey(customErrors.InvalidArgumentError, customErrors.MsgInvalidRequestBody))
+	}
+	if err := c.Validate(req); err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+	return nil
+}
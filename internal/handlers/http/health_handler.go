@@ -0,0 +1,60 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"player_management_system/internal/pkg/health"
+)
+
+// Probe paths, served without authentication or rate limiting like the metrics.
+const (
+	healthzPath = "/healthz"
+	readyzPath  = "/readyz"
+)
+
+// HealthHandler serves the liveness and readiness probes.
+type HealthHandler struct {
+	checker *health.Checker
+}
+
+// NewHealthHandler creates a new HealthHandler.
+func NewHealthHandler(checker *health.Checker) *HealthHandler {
+	return &HealthHandler{checker: checker}
+}
+
+// RegisterRoutes registers the probe routes with the Echo router.
+func (h *HealthHandler) RegisterRoutes(e *echo.Echo) {
+	e.GET(healthzPath, h.Healthz)
+	e.GET(readyzPath, h.Readyz)
+}
+
+// Healthz handles the GET /healthz request. It only tells that the process is alive, so it checks no
+// dependency: a failing database must not get the process restarted.
+func (h *HealthHandler) Healthz(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(http.StatusOK, health.Report{Status: health.StatusUp})
+}
+
+// Readyz handles the GET /readyz request. It reports the status of every component, with 503 when
+// any is down or the server is shutting down.
+func (h *HealthHandler) Readyz(c echo.Context) error {
+	report := h.checker.Ready(c.Request().Context())
+
+	status := http.StatusOK
+	if report.Status != health.StatusUp {
+		status = http.StatusServiceUnavailable
+	}
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(status, report)
+}
+
+// isProbe reports whether the request is a health probe. Those come from the orchestrator, which
+// holds no credentials, and must not be rate limited.
+func isProbe(c echo.Context) bool {
+	switch c.Path() {
+	case healthzPath, readyzPath:
+		return true
+	}
+	return false
+}
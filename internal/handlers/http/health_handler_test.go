@@ -0,0 +1,90 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"player_management_system/internal/pkg/health"
+	"player_management_system/internal/pkg/ratelimit"
+)
+
+// newHealthTestServer serves the probes behind the authentication and rate limit middlewares.
+func newHealthTestServer(t *testing.T, database health.Check) (*echo.Echo, *health.Checker) {
+	checker := health.NewChecker(time.Second)
+	checker.Register("database", database)
+
+	e := newJWTTestServer(t)
+	e.Use(RateLimit(ratelimit.NewMemoryStore(), RateLimitConfig{
+		Read:  ratelimit.Limit{Rate: 1, Burst: 1},
+		Write: ratelimit.Limit{Rate: 1, Burst: 1},
+	}))
+	NewHealthHandler(checker).RegisterRoutes(e)
+	return e, checker
+}
+
+func probe(e *echo.Echo, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHealthz(t *testing.T) {
+	e, _ := newHealthTestServer(t, func(context.Context) error { return errors.New("connection refused") })
+
+	// 데이터베이스가 실패해도 프로세스는 살아 있음
+	rec := probe(e, "/healthz")
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"up"}`, rec.Body.String())
+	assert.Equal(t, "no-store", rec.Header().Get(echo.HeaderCacheControl))
+}
+
+func TestReadyz(t *testing.T) {
+	e, _ := newHealthTestServer(t, func(context.Context) error { return nil })
+
+	// 인증 없이 호출하고 요청 수 제한도 받지 않음
+	for i := 0; i < 3; i++ {
+		rec := probe(e, "/readyz")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"status":"up","components":{"server":{"status":"up"},"database":{"status":"up"}}}`, rec.Body.String())
+		assert.Empty(t, rec.Header().Get("RateLimit-Limit"))
+	}
+}
+
+func TestReadyz_ComponentDown(t *testing.T) {
+	e, _ := newHealthTestServer(t, func(context.Context) error {
+		return errors.New("dial tcp 10.0.3.7:5432: connection refused")
+	})
+
+	rec := probe(e, "/readyz")
+
+	// 실패 원인은 로그에만 남기고 내부 주소는 응답에 드러내지 않음
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.JSONEq(t, `{
+		"status": "down",
+		"components": {
+			"server": {"status": "up"},
+			"database": {"status": "down"}
+		}
+	}`, rec.Body.String())
+	assert.NotContains(t, rec.Body.String(), "10.0.3.7")
+}
+
+func TestReadyz_ShuttingDown(t *testing.T) {
+	e, checker := newHealthTestServer(t, func(context.Context) error { return nil })
+
+	checker.Shutdown()
+	rec := probe(e, "/readyz")
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.JSONEq(t, `{"status":"down","components":{"server":{"status":"down"}}}`, rec.Body.String())
+}
@@ -0,0 +1,167 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	customErrors "player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/idempotency"
+)
+
+// HeaderIdempotencyKey is the request header carrying an idempotency key.
+const HeaderIdempotencyKey = "Idempotency-Key"
+
+const (
+	// maxIdempotencyKeyLength is the longest idempotency key accepted.
+	maxIdempotencyKeyLength = 255
+	// idempotencyLockTimeout bounds how long a request holds its key before other requests may take it over,
+	// and how long a concurrent request waits for it.
+	idempotencyLockTimeout = 30 * time.Second
+	// idempotencyPollInterval is how often a waiting request checks whether the key was released.
+	idempotencyPollInterval = 50 * time.Millisecond
+	// maxIdempotentBodySize is the largest request body read to fingerprint a request.
+	maxIdempotentBodySize = 4 << 20
+)
+
+// replayedHeaders are the response headers stored with an idempotent response and replayed with it.
+// Headers describing the retry itself, such as X-Request-ID and the rate limit headers, are not stored.
+var replayedHeaders = []string{
+	echo.HeaderContentType,
+	echo.HeaderContentDisposition,
+	"Content-Language",
+	echo.HeaderLocation,
+	"ETag",
+}
+
+// Idempotency returns a middleware that makes POST requests carrying an Idempotency-Key header safe to retry.
+// The response of the first request is stored for ttl under the client and the key, and replayed with
+// its replayedHeaders for later requests with the same key. Reusing a key for a different request is rejected with 422, and a
+// request arriving while another one with the same key is in progress waits for it to finish.
+// Server errors are not stored, so that the request can be retried. Multipart uploads, which their
+// handlers limit and parse themselves, are passed on untouched, and other bodies over 4 MiB are rejected with 413.
+// Clients are identified like RateLimit does, so it must run after the authentication middlewares.
+func Idempotency(store idempotency.Store, ttl time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			idempotencyKey := req.Header.Get(HeaderIdempotencyKey)
+			if req.Method != http.MethodPost || idempotencyKey == "" || isMultipart(req) {
+				return next(c)
+			}
+			if len(idempotencyKey) > maxIdempotencyKeyLength {
+				return customErrors.NewHTTPError(customErrors.NewErrorWithKey(customErrors.InvalidArgumentError, customErrors.MsgInvalidIdempotencyKey, maxIdempotencyKeyLength))
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(c.Response(), req.Body, maxIdempotentBodySize))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					return customErrors.HandleHTTPError(c, customErrors.NewError(customErrors.PayloadTooLargeError, "request body is too large"))
+				}
+				return customErrors.NewHTTPError(customErrors.NewErrorWithKey(customErrors.InvalidArgumentError, customErrors.MsgInvalidRequestBody))
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			id, ok := clientID(c)
+			if !ok {
+				id = "ip:" + c.RealIP()
+			}
+			key := id + ":" + idempotencyKey
+			hash := idempotency.HashRequest(req.Method, req.URL.RequestURI(), body)
+			owner := uuid.NewString()
+
+			deadline := time.Now().Add(idempotencyLockTimeout)
+			for {
+				now := time.Now()
+				record, err := store.Begin(req.Context(), key, owner, hash, now, now.Add(idempotencyLockTimeout))
+				if err != nil {
+					return customErrors.HandleHTTPError(c, err)
+				}
+
+				switch {
+				case record == nil:
+					return runIdempotent(c, next, store, key, owner, ttl)
+				case record.RequestHash != hash:
+					return customErrors.HandleHTTPError(c, customErrors.NewError(customErrors.UnprocessableError, "idempotency key was used for a different request"))
+				case record.Completed:
+					header := c.Response().Header()
+					for name, values := range record.Header {
+						header[name] = values
+					}
+					header.Set("Idempotent-Replayed", "true")
+					return c.Blob(record.StatusCode, record.Header.Get(echo.HeaderContentType), record.Body)
+				}
+
+				// 같은 키의 요청이 처리 중이므로 끝날 때까지 대기
+				if now.After(deadline) {
+					return customErrors.HandleHTTPError(c, customErrors.NewError(customErrors.AlreadyExistsError, "a request with this idempotency key is in progress"))
+				}
+				select {
+				case <-req.Context().Done():
+					return req.Context().Err()
+				case <-time.After(idempotencyPollInterval):
+				}
+			}
+		}
+	}
+}
+
+// runIdempotent runs the handler of a request holding an idempotency key and stores its response.
+func runIdempotent(c echo.Context, next echo.HandlerFunc, store idempotency.Store, key, owner string, ttl time.Duration) error {
+	capture := &responseCapture{ResponseWriter: c.Response().Writer}
+	c.Response().Writer = capture
+
+	if err := next(c); err != nil {
+		c.Error(err)
+	}
+
+	// 요청이 취소되어도 키는 정리해야 함
+	ctx := context.WithoutCancel(c.Request().Context())
+	res := c.Response()
+	if res.Status >= http.StatusInternalServerError {
+		if err := store.Abandon(ctx, key, owner); err != nil {
+			slog.ErrorContext(ctx, "failed to release idempotency key", "error", err)
+		}
+		return nil
+	}
+
+	header := http.Header{}
+	for _, name := range replayedHeaders {
+		if values := res.Header().Values(name); len(values) > 0 {
+			header[name] = values
+		}
+	}
+	err := store.Complete(ctx, key, owner, res.Status, header, capture.body.Bytes(), time.Now().Add(ttl))
+	switch {
+	case errors.Is(err, idempotency.ErrKeyLost):
+		// 잠금이 만료되어 다른 요청이 키를 가져갔으므로 그 결과를 덮어쓰지 않음
+		slog.WarnContext(ctx, "idempotency key was taken over before the response was stored")
+	case err != nil:
+		slog.ErrorContext(ctx, "failed to store idempotent response", "error", err)
+	}
+	return nil
+}
+
+// isMultipart reports whether the request body is a multipart form.
+func isMultipart(req *http.Request) bool {
+	return strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm)
+}
+
+// responseCapture copies the response body written through it.
+type responseCapture struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseCapture) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,160 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"player_management_system/internal/pkg/idempotency"
+)
+
+func newIdempotencyTestServer(handler echo.HandlerFunc) *echo.Echo {
+	e := newTestEcho()
+	e.Use(Idempotency(idempotency.NewMemoryStore(), time.Hour))
+	e.POST("/players", handler)
+	return e
+}
+
+func postIdempotent(e *echo.Echo, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/players", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if key != "" {
+		req.Header.Set(HeaderIdempotencyKey, key)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotency_Replay(t *testing.T) {
+	var calls int32
+	e := newIdempotencyTestServer(func(c echo.Context) error {
+		n := atomic.AddInt32(&calls, 1)
+		c.Response().Header().Set(echo.HeaderLocation, "/players/1")
+		c.Response().Header().Set("RateLimit-Remaining", "9")
+		return c.JSON(http.StatusCreated, map[string]int32{"call": n})
+	})
+
+	first := postIdempotent(e, "k1", `{"name":"김도영"}`)
+	assert.Equal(t, http.StatusCreated, first.Code)
+
+	replay := postIdempotent(e, "k1", `{"name":"김도영"}`)
+	assert.Equal(t, http.StatusCreated, replay.Code)
+	assert.Equal(t, first.Body.String(), replay.Body.String())
+	assert.Equal(t, "true", replay.Header().Get("Idempotent-Replayed"))
+	assert.Equal(t, echo.MIMEApplicationJSON, replay.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, "/players/1", replay.Header().Get(echo.HeaderLocation))
+	// 재시도 자체에 대한 헤더는 저장하지 않음
+	assert.Empty(t, replay.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// 다른 본문으로 재사용하면 거부됨
+	mismatch := postIdempotent(e, "k1", `{"name":"양현종"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, mismatch.Code)
+
+	// 키가 없으면 매번 처리됨
+	postIdempotent(e, "", `{"name":"김도영"}`)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestIdempotency_ErrorsAreReplayed(t *testing.T) {
+	var calls int32
+	e := newIdempotencyTestServer(func(c echo.Context) error {
+		atomic.AddInt32(&calls, 1)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid argument: name")
+	})
+
+	assert.Equal(t, http.StatusBadRequest, postIdempotent(e, "k1", `{}`).Code)
+	assert.Equal(t, http.StatusBadRequest, postIdempotent(e, "k1", `{}`).Code)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestIdempotency_ServerErrorsAreRetried(t *testing.T) {
+	var calls int32
+	e := newIdempotencyTestServer(func(c echo.Context) error {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return echo.NewHTTPError(http.StatusServiceUnavailable, "database connection is not established")
+		}
+		return c.NoContent(http.StatusCreated)
+	})
+
+	assert.Equal(t, http.StatusServiceUnavailable, postIdempotent(e, "k1", `{}`).Code)
+	assert.Equal(t, http.StatusCreated, postIdempotent(e, "k1", `{}`).Code)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestIdempotency_ConcurrentRequestsAreSerialized(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	e := newIdempotencyTestServer(func(c echo.Context) error {
+		n := atomic.AddInt32(&calls, 1)
+		<-release
+		return c.JSON(http.StatusCreated, map[string]int32{"call": n})
+	})
+
+	const requests = 5
+	var wg sync.WaitGroup
+	results := make([]*httptest.ResponseRecorder, requests)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = postIdempotent(e, "k1", `{"name":"김도영"}`)
+		}(i)
+	}
+
+	// 첫 요청이 처리 중인 동안 나머지는 대기
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	for _, rec := range results {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{"call":1}`, rec.Body.String())
+	}
+}
+
+func TestIdempotency_QueryIsPartOfTheRequest(t *testing.T) {
+	e := newIdempotencyTestServer(func(c echo.Context) error {
+		return c.NoContent(http.StatusCreated)
+	})
+
+	assert.Equal(t, http.StatusCreated, postIdempotent(e, "k1", `{}`).Code)
+
+	// 쿼리가 다르면 다른 요청으로 취급됨
+	req := httptest.NewRequest(http.MethodPost, "/players?upsert=true", strings.NewReader(`{}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(HeaderIdempotencyKey, "k1")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestIdempotency_BodyLimit(t *testing.T) {
+	var calls int32
+	e := newIdempotencyTestServer(func(c echo.Context) error {
+		atomic.AddInt32(&calls, 1)
+		return c.NoContent(http.StatusCreated)
+	})
+
+	rec := postIdempotent(e, "k1", strings.Repeat("a", maxIdempotentBodySize+1))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+	// 멀티파트 업로드는 핸들러가 직접 제한하므로 그대로 전달됨
+	req := httptest.NewRequest(http.MethodPost, "/players", strings.NewReader(strings.Repeat("a", maxIdempotentBodySize+1)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEMultipartForm+"; boundary=x")
+	req.Header.Set(HeaderIdempotencyKey, "k2")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
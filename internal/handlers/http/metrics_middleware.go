@@ -0,0 +1,43 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"player_management_system/internal/pkg/metrics"
+)
+
+// unmatchedRoute labels the requests that match no route, so that unknown paths do not each make
+// a new series. Echo gives the others the template of the closest route.
+const unmatchedRoute = "unmatched"
+
+// metricsPath is where the admin server serves the metrics for scraping.
+const metricsPath = "/metrics"
+
+// Metrics returns a middleware that records the method, route template, status and latency of every
+// request. Errors are handed to the error handler here so that the status they are rendered with is
+// the one recorded. It must be registered before Recover so that recovered panics are recorded too.
+func Metrics(m *metrics.HTTPMetrics) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+			m.Observe(c.Request().Method, route, c.Response().Status, time.Since(start))
+			return nil
+		}
+	}
+}
+
+// RegisterMetricsRoute serves the metrics at /metrics with handler. The metrics are unauthenticated,
+// so e must be an admin server listening on a port that is not exposed to the public network.
+func RegisterMetricsRoute(e *echo.Echo, handler http.Handler) {
+	e.GET(metricsPath, echo.WrapHandler(handler))
+}
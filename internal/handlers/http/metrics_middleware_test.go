@@ -0,0 +1,75 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+
+	"player_management_system/internal/pkg/auth"
+	customErrors "player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/metrics"
+)
+
+// newMetricsTestServer records the requests, which need a bearer token, and serves the metrics on a
+// separate admin server.
+func newMetricsTestServer(t *testing.T) (e, admin *echo.Echo) {
+	verifier, err := auth.NewJWTVerifier(auth.JWTConfig{HMACSecret: testJWTSecret})
+	assert.NoError(t, err)
+
+	reg := metrics.NewRegistry()
+	e = echo.New()
+	e.HTTPErrorHandler = customErrors.HTTPErrorHandler
+	e.Use(Metrics(metrics.NewHTTPMetrics(reg)))
+	e.Use(middleware.Recover())
+	e.Use(JWTAuth(verifier))
+
+	e.GET("/players/:id", func(c echo.Context) error {
+		if c.Param("id") == "missing" {
+			return customErrors.NewHTTPError(customErrors.NewError(customErrors.NotFoundError, "player not found"))
+		}
+		return c.NoContent(http.StatusOK)
+	})
+	e.GET("/panic", func(c echo.Context) error {
+		panic("boom")
+	})
+	admin = echo.New()
+	RegisterMetricsRoute(admin, metrics.Handler(reg))
+	return e, admin
+}
+
+func TestMetrics(t *testing.T) {
+	e, admin := newMetricsTestServer(t)
+	token := mintToken(t, "user-1", []string{"viewer"}, "", time.Hour)
+
+	for _, path := range []string{"/players/1", "/players/2", "/players/missing", "/panic", "/unknown/1", "/unknown/2"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	// 토큰 없이 요청하면 401로 기록됨
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/players/3", nil))
+
+	// 공개 서버에서는 지표를 내보내지 않음
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// 관리 서버에서는 인증 없이 수집함
+	rec = httptest.NewRecorder()
+	admin.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	// 경로 대신 경로 템플릿으로, 오류는 응답한 상태 코드로 기록됨
+	assert.Contains(t, body, `player_management_http_requests_total{method="GET",route="/players/:id",status="200"} 2`)
+	assert.Contains(t, body, `player_management_http_requests_total{method="GET",route="/players/:id",status="404"} 1`)
+	assert.Contains(t, body, `player_management_http_requests_total{method="GET",route="/players/:id",status="401"} 1`)
+	assert.Contains(t, body, `player_management_http_requests_total{method="GET",route="/panic",status="500"} 1`)
+	assert.Contains(t, body, `player_management_http_requests_total{method="GET",route="unmatched",status="404"} 2`)
+	assert.Contains(t, body, `player_management_http_request_duration_seconds_count{method="GET",route="/players/:id",status="200"} 2`)
+}
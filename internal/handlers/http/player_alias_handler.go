@@ -0,0 +1,39 @@
+package http
+
+import (
+	playerDomain "player_management_system/internal/domains/players"
+)
+
+// AliasRequest represents an alias in a request body.
+type AliasRequest struct {
+	Name    string `json:"name" validate:"required,max=200"`
+	Type    string `json:"type" validate:"oneof=legal romanized nickname former_name"`
+	Locale  string `json:"locale" validate:"omitempty,bcp47_language_tag"`
+	Primary bool   `json:"primary"`
+}
+
+// parseAliases validates the aliases of a request and, when romanize is set, adds the romanizations
+// of a Hangul player name. Without aliases or romanization the result is nil, which leaves the stored
+// aliases untouched.
+func parseAliases(reqs []AliasRequest, name string, romanize bool) ([]*playerDomain.Alias, error) {
+	if reqs == nil && !romanize {
+		return nil, nil
+	}
+
+	aliases := make([]*playerDomain.Alias, 0, len(reqs))
+	for _, r := range reqs {
+		a, err := playerDomain.NewAlias(r.Name, r.Type, r.Locale, r.Primary)
+		if err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, a)
+	}
+	if romanize {
+		aliases = playerDomain.AddRomanizedAliases(aliases, name)
+	}
+
+	if err := playerDomain.ValidateAliases(aliases); err != nil {
+		return nil, err
+	}
+	return aliases, nil
+}
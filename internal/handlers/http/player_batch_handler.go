@@ -0,0 +1,204 @@
+package http
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/sync/errgroup"
+	playerDomain "player_management_system/internal/domains/players"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+// batchURLCheckConcurrency bounds the profile image URLs of a batch checked at the same time.
+const batchURLCheckConcurrency = 8
+
+// BatchPlayerRequest represents a single player in a batch request.
+type BatchPlayerRequest struct {
+	CreatePlayerRequest
+	// ExternalID is stored in the default external ID namespace, so the player can also be found
+	// at /players/by-external-id/default/{external_id}.
+	ExternalID string `json:"external_id" validate:"max=255"`
+}
+
+// BatchCreatePlayersRequest represents the request body of POST /players:batch.
+type BatchCreatePlayersRequest struct {
+	Mode    playerDomain.BatchMode `json:"mode" validate:"omitempty,oneof=atomic partial"`
+	Upsert  bool                   `json:"upsert"`
+	Players []BatchPlayerRequest   `json:"players"`
+}
+
+// BatchItemResponse represents the outcome of a single player in a batch request.
+type BatchItemResponse struct {
+	Index  int                          `json:"index"`
+	Status int                          `json:"status"`
+	Result playerDomain.BatchItemStatus `json:"result"`
+	Player *playerDomain.Player         `json:"player,omitempty"`
+	Error  *customErrors.Problem        `json:"error,omitempty"`
+}
+
+// BatchCreatePlayersResponse represents the response body of POST /players:batch.
+type BatchCreatePlayersResponse struct {
+	Results []BatchItemResponse `json:"results"`
+}
+
+// BatchCreatePlayers handles the POST /players:batch request.
+// In atomic mode the batch is rejected as a whole when any player is invalid or conflicts.
+// In partial mode the valid players are stored and a 207 Multi-Status with per-player results is returned.
+func (h *PlayerHandler) BatchCreatePlayers(c echo.Context) error {
+	var req BatchCreatePlayersRequest
+	// 선수들은 부분 모드에서 각각 보고하기 위해 아래에서 따로 검증함
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if req.Mode == "" {
+		req.Mode = playerDomain.BatchModeAtomic
+	}
+	if len(req.Players) == 0 {
+		return customErrors.NewHTTPError(customErrors.NewFieldError("players", customErrors.MsgNotEmpty))
+	}
+	if len(req.Players) > playerDomain.MaxBatchSize {
+		return customErrors.NewHTTPError(customErrors.NewErrorWithKey(customErrors.PayloadTooLargeError, customErrors.MsgBatchTooLarge, playerDomain.MaxBatchSize))
+	}
+
+	// 각 선수를 도메인 규칙으로 검증
+	players := make([]*playerDomain.Player, len(req.Players))
+	errs := make([]error, len(req.Players))
+	seen := make(map[string]int)
+	for i, item := range req.Players {
+		players[i], errs[i] = newBatchPlayer(c, i, item, req.Upsert, seen)
+	}
+	h.checkBatchProfileImageURLs(c, players, errs)
+
+	var (
+		invalid []BatchItemResponse
+		valid   []*playerDomain.Player
+		indexes []int
+	)
+	for i, p := range players {
+		if errs[i] != nil {
+			invalid = append(invalid, batchItemError(c, i, playerDomain.BatchItemInvalid, errs[i]))
+			continue
+		}
+		valid = append(valid, p)
+		indexes = append(indexes, i)
+	}
+
+	if len(invalid) > 0 && req.Mode == playerDomain.BatchModeAtomic {
+		return c.JSON(http.StatusBadRequest, BatchCreatePlayersResponse{Results: invalid})
+	}
+
+	statuses, err := h.playerService.CreatePlayers(c.Request().Context(), valid, req.Mode, req.Upsert)
+	// 원자적 배치에서 충돌이 발생하면 전체가 롤백되지만 충돌한 선수는 보고함
+	rolledBack := err != nil && statuses != nil
+	if err != nil && !rolledBack {
+		return customErrors.NewHTTPError(err)
+	}
+
+	results := invalid
+	for j, status := range statuses {
+		if status == playerDomain.BatchItemConflict {
+			conflict := customErrors.NewError(customErrors.AlreadyExistsError, "player already exists")
+			results = append(results, batchItemError(c, indexes[j], status, conflict))
+			continue
+		}
+		if rolledBack {
+			continue
+		}
+		code := http.StatusCreated
+		if status == playerDomain.BatchItemUpdated {
+			code = http.StatusOK
+		}
+		h.mirrorProfileImage(c.Request().Context(), valid[j])
+		results = append(results, BatchItemResponse{Index: indexes[j], Status: code, Result: status, Player: valid[j]})
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Index < results[j].Index })
+
+	switch {
+	case rolledBack:
+		return c.JSON(customErrors.GetHTTPStatusCode(err), BatchCreatePlayersResponse{Results: results})
+	case req.Mode == playerDomain.BatchModePartial:
+		return c.JSON(http.StatusMultiStatus, BatchCreatePlayersResponse{Results: results})
+	case req.Upsert:
+		return c.JSON(http.StatusOK, BatchCreatePlayersResponse{Results: results})
+	default:
+		return c.JSON(http.StatusCreated, BatchCreatePlayersResponse{Results: results})
+	}
+}
+
+// newBatchPlayer validates a batch item with the domain rules and builds its player.
+// seen maps the external IDs of the previous items to their indexes.
+func newBatchPlayer(c echo.Context, index int, item BatchPlayerRequest, upsert bool, seen map[string]int) (*playerDomain.Player, error) {
+	if err := c.Validate(&item); err != nil {
+		return nil, err
+	}
+	p, err := playerDomain.NewPlayer(item.Name, item.Sport, item.Team, item.ProfileImageURL)
+	if err != nil {
+		return nil, err
+	}
+	if p.BirthDate, err = playerDomain.ParseBirthDate(item.BirthDate); err != nil {
+		return nil, err
+	}
+	if err := checkExternalID(index, item.ExternalID, upsert, seen); err != nil {
+		return nil, err
+	}
+	if item.ExternalIDs != nil {
+		return nil, customErrors.NewFieldError("external_ids", customErrors.MsgNotSupportedInBatch)
+	}
+	if item.Aliases != nil || item.Romanize {
+		return nil, customErrors.NewFieldError("aliases", customErrors.MsgNotSupportedInBatch)
+	}
+	p.ExternalID = item.ExternalID
+	return p, nil
+}
+
+// checkBatchProfileImageURLs checks the profile image URLs of the valid batch items concurrently,
+// since each check may resolve a host, and records the failures in errs.
+func (h *PlayerHandler) checkBatchProfileImageURLs(c echo.Context, players []*playerDomain.Player, errs []error) {
+	if h.remoteImages == nil {
+		return
+	}
+
+	var g errgroup.Group
+	g.SetLimit(batchURLCheckConcurrency)
+	for i, p := range players {
+		if errs[i] != nil || p.ProfileImageURL == "" {
+			continue
+		}
+		g.Go(func() error {
+			errs[i] = h.checkProfileImageURL(c.Request().Context(), uuid.Nil, p.ProfileImageURL)
+			return nil
+		})
+	}
+	g.Wait()
+}
+
+// checkExternalID validates the external ID of a batch item.
+// Upserts are keyed on the external ID, so it is required there, and it must be unique within the batch.
+func checkExternalID(index int, externalID string, upsert bool, seen map[string]int) error {
+	if externalID == "" {
+		if upsert {
+			return customErrors.NewFieldError("external_id", customErrors.MsgRequired)
+		}
+		return nil
+	}
+	if first, ok := seen[externalID]; ok {
+		return customErrors.NewFieldError("external_id", customErrors.MsgDuplicateInBatch, first)
+	}
+	seen[externalID] = index
+	return nil
+}
+
+// batchItemError builds the result of a failed batch item. The error is described as problem details
+// in the language of the request, like the errors of other requests.
+func batchItemError(c echo.Context, index int, status playerDomain.BatchItemStatus, err error) BatchItemResponse {
+	p := customErrors.NewRequestProblem(c, err)
+	return BatchItemResponse{
+		Index:  index,
+		Status: p.Status,
+		Result: status,
+		Error:  p,
+	}
+}
@@ -0,0 +1,251 @@
+package http
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/xuri/excelize/v2"
+	playerDomain "player_management_system/internal/domains/players"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+// Export formats.
+const (
+	exportFormatCSV    = "csv"
+	exportFormatNDJSON = "ndjson"
+	exportFormatXLSX   = "xlsx"
+)
+
+// exportContentTypes maps each export format to its content type.
+var exportContentTypes = map[string]string{
+	exportFormatCSV:    "text/csv; charset=utf-8",
+	exportFormatNDJSON: "application/x-ndjson",
+	exportFormatXLSX:   "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// playerRowWriter writes exported players one at a time.
+type playerRowWriter interface {
+	Write(p *playerDomain.Player) error
+	// Flush writes out the rows still buffered once every player has been written.
+	Flush() error
+	// Close releases the resources of the writer.
+	Close() error
+}
+
+// exportResponse is the body of an export. The status and the download headers are only sent with
+// the first bytes of the file, so that errors raised before, such as a denied authorization or a
+// failed query, are still rendered as problems.
+type exportResponse struct {
+	res         *echo.Response
+	contentType string
+	filename    string
+}
+
+func (r *exportResponse) Write(b []byte) (int, error) {
+	if !r.res.Committed {
+		r.res.Header().Set(echo.HeaderContentType, r.contentType)
+		r.res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", r.filename))
+		r.res.WriteHeader(http.StatusOK)
+	}
+	return r.res.Write(b)
+}
+
+// ExportPlayers handles the GET /players/export request.
+// It takes the same filters and fieldset as GET /players and streams every matching player
+// as CSV, NDJSON or XLSX depending on ?format=.
+// Once part of the file has been sent its status can no longer change, so a failure after that
+// aborts the connection and the client sees an incomplete download rather than a short file.
+func (h *PlayerHandler) ExportPlayers(c echo.Context) error {
+	format := c.QueryParam("format")
+	if format == "" {
+		format = exportFormatCSV
+	}
+	contentType, ok := exportContentTypes[format]
+	if !ok {
+		return customErrors.NewHTTPError(customErrors.NewFieldError("format", customErrors.MsgOneOf, exportFormatCSV+", "+exportFormatNDJSON+", "+exportFormatXLSX))
+	}
+
+	opts, err := playerDomain.ParseReadOptions(c.QueryParam("fields"), "")
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+	fields := opts.Fields
+	if len(fields) == 0 {
+		fields = playerDomain.PlayerFields
+	}
+
+	out := &exportResponse{
+		res:         c.Response(),
+		contentType: contentType,
+		filename:    fmt.Sprintf("players-%s.%s", time.Now().Format("20060102"), format),
+	}
+
+	var writer playerRowWriter
+	switch format {
+	case exportFormatCSV:
+		writer, err = newCSVPlayerWriter(out, fields)
+	case exportFormatNDJSON:
+		writer = newNDJSONPlayerWriter(out, opts)
+	case exportFormatXLSX:
+		writer, err = newXLSXPlayerWriter(out, fields)
+	}
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+	defer writer.Close()
+
+	err = h.playerService.ExportPlayers(c.Request().Context(), playerFilter(c), opts, writer.Write)
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil && c.Response().Committed {
+		// 응답을 시작한 뒤에는 상태 코드를 바꿀 수 없으므로 연결을 끊어 파일이 잘렸음을 알림
+		slog.ErrorContext(c.Request().Context(), "export aborted", "format", format, "error", err)
+		panic(http.ErrAbortHandler)
+	}
+	return err
+}
+
+// csvPlayerWriter writes players as CSV rows with a header row.
+type csvPlayerWriter struct {
+	w      *csv.Writer
+	fields []string
+}
+
+func newCSVPlayerWriter(out io.Writer, fields []string) (*csvPlayerWriter, error) {
+	w := csv.NewWriter(out)
+	if err := w.Write(fields); err != nil {
+		return nil, err
+	}
+	return &csvPlayerWriter{w: w, fields: fields}, nil
+}
+
+func (cw *csvPlayerWriter) Write(p *playerDomain.Player) error {
+	return cw.w.Write(playerFieldValues(p, cw.fields))
+}
+
+func (cw *csvPlayerWriter) Flush() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+func (cw *csvPlayerWriter) Close() error {
+	return nil
+}
+
+// ndjsonPlayerWriter writes one JSON object per line.
+type ndjsonPlayerWriter struct {
+	enc  *json.Encoder
+	opts playerDomain.ReadOptions
+}
+
+func newNDJSONPlayerWriter(w io.Writer, opts playerDomain.ReadOptions) *ndjsonPlayerWriter {
+	return &ndjsonPlayerWriter{enc: json.NewEncoder(w), opts: opts}
+}
+
+func (nw *ndjsonPlayerWriter) Write(p *playerDomain.Player) error {
+	view, err := playerView(p, nw.opts)
+	if err != nil {
+		return err
+	}
+	return nw.enc.Encode(view)
+}
+
+func (nw *ndjsonPlayerWriter) Flush() error {
+	return nil
+}
+
+func (nw *ndjsonPlayerWriter) Close() error {
+	return nil
+}
+
+// xlsxPlayerWriter writes players to a single sheet through the excelize stream writer,
+// which spills rows to a temporary file instead of keeping them in memory.
+type xlsxPlayerWriter struct {
+	out    io.Writer
+	file   *excelize.File
+	sw     *excelize.StreamWriter
+	fields []string
+	row    int
+}
+
+func newXLSXPlayerWriter(out io.Writer, fields []string) (*xlsxPlayerWriter, error) {
+	file := excelize.NewFile()
+	sw, err := file.NewStreamWriter(file.GetSheetName(0))
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	xw := &xlsxPlayerWriter{out: out, file: file, sw: sw, fields: fields, row: 1}
+	if err := xw.writeRow(fields); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return xw, nil
+}
+
+func (xw *xlsxPlayerWriter) Write(p *playerDomain.Player) error {
+	return xw.writeRow(playerFieldValues(p, xw.fields))
+}
+
+func (xw *xlsxPlayerWriter) writeRow(values []string) error {
+	cells := make([]interface{}, len(values))
+	for i, v := range values {
+		cells[i] = v
+	}
+	cell, err := excelize.CoordinatesToCellName(1, xw.row)
+	if err != nil {
+		return err
+	}
+	xw.row++
+	return xw.sw.SetRow(cell, cells)
+}
+
+func (xw *xlsxPlayerWriter) Flush() error {
+	if err := xw.sw.Flush(); err != nil {
+		return err
+	}
+	return xw.file.Write(xw.out)
+}
+
+// Close removes the temporary files of the workbook.
+func (xw *xlsxPlayerWriter) Close() error {
+	return xw.file.Close()
+}
+
+// playerFieldValues returns the string values of the given player fields.
+func playerFieldValues(p *playerDomain.Player, fields []string) []string {
+	values := make([]string, len(fields))
+	for i, f := range fields {
+		switch f {
+		case "id":
+			values[i] = p.ID.String()
+		case "name":
+			values[i] = p.Name
+		case "sport":
+			values[i] = p.Sport
+		case "team":
+			values[i] = p.Team
+		case "profile_image_url":
+			values[i] = p.ProfileImageURL
+		case "external_id":
+			values[i] = p.ExternalID
+		case "birth_date":
+			if p.BirthDate != nil {
+				values[i] = p.BirthDate.Format(playerDomain.BirthDateLayout)
+			}
+		case "created_at":
+			values[i] = p.CreatedAt.Format(time.RFC3339)
+		case "updated_at":
+			values[i] = p.UpdatedAt.Format(time.RFC3339)
+		}
+	}
+	return values
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	playerDomain "player_management_system/internal/domains/players"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+// ExternalIDRequest represents an external identifier in a request body.
+type ExternalIDRequest struct {
+	Namespace string `json:"namespace" validate:"required,max=64"`
+	Value     string `json:"value" validate:"required,max=255"`
+}
+
+// GetPlayerByExternalID handles the GET /players/by-external-id/:namespace/:value request.
+// It supports ?fields= and ?expand= like GET /players/:id.
+func (h *PlayerHandler) GetPlayerByExternalID(c echo.Context) error {
+	id, err := playerDomain.NewExternalIdentifier(c.Param("namespace"), c.Param("value"))
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	opts, err := playerDomain.ParseReadOptions(c.QueryParam("fields"), c.QueryParam("expand"))
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	p, err := h.playerService.GetPlayerByExternalID(c.Request().Context(), id.Namespace, id.Value, opts)
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	view, err := playerView(p, opts)
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	return c.JSON(http.StatusOK, view)
+}
+
+// parseExternalIDs validates the external identifiers of a request. A nil list stays nil,
+// which leaves the stored identifiers untouched.
+func parseExternalIDs(reqs []ExternalIDRequest) ([]*playerDomain.ExternalIdentifier, error) {
+	if reqs == nil {
+		return nil, nil
+	}
+
+	ids := make([]*playerDomain.ExternalIdentifier, 0, len(reqs))
+	for _, r := range reqs {
+		id, err := playerDomain.NewExternalIdentifier(r.Namespace, r.Value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err := playerDomain.ValidateExternalIdentifiers(ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
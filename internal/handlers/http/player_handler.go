@@ -1,6 +1,8 @@
 package http
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"strconv"
 
@@ -9,69 +11,214 @@ import (
 	playerDomain "player_management_system/internal/domains/players"
 	customErrors "player_management_system/internal/pkg/errors"
 	playerService "player_management_system/internal/services/player"
+	"player_management_system/internal/services/profileimage"
 )
 
 // PlayerHandler handles HTTP requests for player operations.
 type PlayerHandler struct {
 	playerService playerService.PlayerService
+	remoteImages  profileimage.RemoteImageService
+}
+
+// PlayerHandlerOption configures an optional dependency of a PlayerHandler.
+type PlayerHandlerOption func(*PlayerHandler)
+
+// WithRemoteImages checks submitted profile image URLs with the service and has it mirror the images they point to.
+func WithRemoteImages(remoteImages profileimage.RemoteImageService) PlayerHandlerOption {
+	return func(h *PlayerHandler) {
+		h.remoteImages = remoteImages
+	}
 }
 
 // NewPlayerHandler creates a new PlayerHandler.
-func NewPlayerHandler(playerService playerService.PlayerService) *PlayerHandler {
-	return &PlayerHandler{playerService: playerService}
+func NewPlayerHandler(playerService playerService.PlayerService, opts ...PlayerHandlerOption) *PlayerHandler {
+	h := &PlayerHandler{playerService: playerService}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // RegisterRoutes registers the player routes with the Echo router.
 func (h *PlayerHandler) RegisterRoutes(e *echo.Echo) {
 	e.POST("/players", h.CreatePlayer)
+	e.POST("/players\\:batch", h.BatchCreatePlayers)
 	e.GET("/players/:id", h.GetPlayer)
+	e.PUT("/players/:id", h.UpdatePlayer)
+	e.GET("/players/by-external-id/:namespace/:value", h.GetPlayerByExternalID)
+	e.GET("/players/:id/profile", h.GetPlayerProfile)
 	e.GET("/players", h.GetPlayers)
+	e.GET("/players/export", h.ExportPlayers)
+	e.GET("/players/duplicates", h.FindDuplicates)
+	e.POST("/players/:id/merge", h.MergePlayers)
 }
 
 // CreatePlayerRequest represents the request body for creating a new player.
 type CreatePlayerRequest struct {
-	Name            string `json:"name"`
-	Sport           string `json:"sport"`
-	Team            string `json:"team"`
-	ProfileImageURL string `json:"profile_image_url"`
+	Name            string `json:"name" validate:"required,max=100"`
+	Sport           string `json:"sport" validate:"required,max=50"`
+	Team            string `json:"team" validate:"required,max=100"`
+	ProfileImageURL string `json:"profile_image_url" validate:"omitempty,imageurl"`
+	// BirthDate is written as YYYY-MM-DD and is optional.
+	BirthDate string `json:"birth_date" validate:"omitempty,pastdate"`
+	// ExternalIDs links the player to other systems and is optional.
+	ExternalIDs []ExternalIDRequest `json:"external_ids" validate:"max=20,dive"`
+	// Aliases are the other names of the player and are optional.
+	Aliases []AliasRequest `json:"aliases" validate:"max=50,dive"`
+	// Romanize adds the Revised Romanization of a Hangul name to the aliases.
+	Romanize bool `json:"romanize"`
 }
 
 func (h *PlayerHandler) CreatePlayer(c echo.Context) error {
 	var req CreatePlayerRequest
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
 	}
 
 	p, err := playerDomain.NewPlayer(req.Name, req.Sport, req.Team, req.ProfileImageURL)
+	if err == nil {
+		p.BirthDate, err = playerDomain.ParseBirthDate(req.BirthDate)
+	}
+	if err == nil {
+		p.ExternalIDs, err = parseExternalIDs(req.ExternalIDs)
+	}
+	if err == nil {
+		p.Aliases, err = parseAliases(req.Aliases, req.Name, req.Romanize)
+	}
+	if err == nil {
+		err = h.checkProfileImageURL(c.Request().Context(), uuid.Nil, p.ProfileImageURL)
+	}
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return customErrors.NewHTTPError(err)
 	}
 
 	err = h.playerService.CreatePlayer(c.Request().Context(), p)
 	if err != nil {
-		return echo.NewHTTPError(customErrors.GetHTTPStatusCode(err), err.Error())
+		return customErrors.NewHTTPError(err)
 	}
+	h.mirrorProfileImage(c.Request().Context(), p)
 
 	return c.JSON(http.StatusCreated, p)
 }
 
+// checkProfileImageURL checks that a profile image URL submitted for a player is ours or points to a
+// public host. New players are given as uuid.Nil.
+func (h *PlayerHandler) checkProfileImageURL(ctx context.Context, id uuid.UUID, url string) error {
+	if h.remoteImages == nil {
+		return nil
+	}
+	return h.remoteImages.CheckURL(ctx, id, url)
+}
+
+// mirrorProfileImage queues copying a stored player's remote profile image into our storage.
+// The response does not wait for it; the player keeps the remote URL until the copy is stored.
+func (h *PlayerHandler) mirrorProfileImage(ctx context.Context, p *playerDomain.Player) {
+	if h.remoteImages != nil {
+		h.remoteImages.Mirror(ctx, p.ID, p.ProfileImageURL)
+	}
+}
+
+// UpdatePlayerRequest represents the request body for replacing a player.
+// Omitting external_ids or aliases keeps the stored ones, an empty list removes them.
+type UpdatePlayerRequest struct {
+	CreatePlayerRequest
+}
+
+// UpdatePlayer handles the PUT /players/:id request.
+func (h *PlayerHandler) UpdatePlayer(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return customErrors.NewHTTPError(customErrors.NewFieldError("id", customErrors.MsgInvalidUUID))
+	}
+
+	var req UpdatePlayerRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	p, err := playerDomain.NewPlayer(req.Name, req.Sport, req.Team, req.ProfileImageURL)
+	if err == nil {
+		p.BirthDate, err = playerDomain.ParseBirthDate(req.BirthDate)
+	}
+	if err == nil {
+		p.ExternalIDs, err = parseExternalIDs(req.ExternalIDs)
+	}
+	if err == nil {
+		p.Aliases, err = parseAliases(req.Aliases, req.Name, req.Romanize)
+	}
+	if err == nil {
+		err = h.checkProfileImageURL(c.Request().Context(), id, p.ProfileImageURL)
+	}
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+	p.ID = id
+
+	ctx := c.Request().Context()
+	if err := h.playerService.UpdatePlayer(ctx, p); err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+	h.mirrorProfileImage(c.Request().Context(), p)
+
+	// 생성 시각 등 저장된 값을 포함해 응답하기 위해 다시 조회
+	opts := playerDomain.ReadOptions{Expand: []string{playerDomain.ExpandExternalIDs, playerDomain.ExpandAliases}}
+	updated, err := h.playerService.GetPlayerByIDWithOptions(ctx, id, opts)
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	return c.JSON(http.StatusOK, updated)
+}
+
 // GetPlayer handles the GET /players/:id request.
+// It supports ?fields= for a sparse fieldset and ?expand= for embedded relations; aliases are always embedded.
+// Merged players are redirected to the player they were merged into.
 func (h *PlayerHandler) GetPlayer(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "Invalid player ID")
+		return customErrors.NewHTTPError(customErrors.NewFieldError("id", customErrors.MsgInvalidUUID))
 	}
 
-	p, err := h.playerService.GetPlayerByID(c.Request().Context(), id)
+	opts, err := playerDomain.ParseReadOptions(c.QueryParam("fields"), c.QueryParam("expand"))
 	if err != nil {
-		return echo.NewHTTPError(customErrors.GetHTTPStatusCode(err), err.Error())
+		return customErrors.NewHTTPError(err)
 	}
+	if !opts.Expands(playerDomain.ExpandAliases) {
+		opts.Expand = append(opts.Expand, playerDomain.ExpandAliases)
+	}
+
+	p, err := h.playerService.GetPlayerByIDWithOptions(c.Request().Context(), id, opts)
+	if err != nil {
+		return h.playerNotFound(c, id, "", err)
+	}
+
+	view, err := playerView(p, opts)
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	return c.JSON(http.StatusOK, view)
+}
 
-	return c.JSON(http.StatusOK, p)
+// GetPlayerProfile handles the GET /players/:id/profile request.
+func (h *PlayerHandler) GetPlayerProfile(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return customErrors.NewHTTPError(customErrors.NewFieldError("id", customErrors.MsgInvalidUUID))
+	}
+
+	profile, err := h.playerService.GetPlayerProfile(c.Request().Context(), id)
+	if err != nil {
+		return h.playerNotFound(c, id, "/profile", err)
+	}
+
+	return c.JSON(http.StatusOK, profile)
 }
 
 // GetPlayers handles the GET /players request.
+// It supports ?name=, ?sport= and ?team= filters, ?fields= for a sparse fieldset and ?expand= for embedded relations.
+// Players are listed in creation order, at most playerDomain.MaxPageSize per page.
 func (h *PlayerHandler) GetPlayers(c echo.Context) error {
 	// 페이지 및 페이지 크기 파라미터 파싱
 	page, err := strconv.Atoi(c.QueryParam("page"))
@@ -81,14 +228,74 @@ func (h *PlayerHandler) GetPlayers(c echo.Context) error {
 
 	size, err := strconv.Atoi(c.QueryParam("size"))
 	if err != nil || size < 1 {
-		size = 10 // 기본값 설정
+		size = playerDomain.DefaultPageSize // 기본값 설정
+	}
+	if size > playerDomain.MaxPageSize {
+		size = playerDomain.MaxPageSize
+	}
+
+	opts, err := playerDomain.ParseReadOptions(c.QueryParam("fields"), c.QueryParam("expand"))
+	if err != nil {
+		return customErrors.NewHTTPError(err)
 	}
 
 	// 서비스 호출
-	players, err := h.playerService.GetPlayersWithPagination(c.Request().Context(), page, size)
+	players, err := h.playerService.GetPlayersWithOptions(c.Request().Context(), page, size, playerFilter(c), opts)
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	views := make([]interface{}, 0, len(players))
+	for _, p := range players {
+		view, err := playerView(p, opts)
+		if err != nil {
+			return customErrors.NewHTTPError(err)
+		}
+		views = append(views, view)
+	}
+
+	return c.JSON(http.StatusOK, views)
+}
+
+// playerFilter reads the player listing filters from the query string.
+func playerFilter(c echo.Context) playerDomain.PlayerFilter {
+	return playerDomain.PlayerFilter{
+		Name:  c.QueryParam("name"),
+		Sport: c.QueryParam("sport"),
+		Team:  c.QueryParam("team"),
+	}
+}
+
+// playerView returns the representation of a player restricted to the requested fields.
+// Expanded relations are always kept, as empty lists when the player has none.
+func playerView(p *playerDomain.Player, opts playerDomain.ReadOptions) (interface{}, error) {
+	if len(opts.Fields) == 0 && len(opts.Expand) == 0 {
+		return p, nil
+	}
+
+	raw, err := json.Marshal(p)
 	if err != nil {
-		return echo.NewHTTPError(customErrors.GetHTTPStatusCode(err), err.Error())
+		return nil, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &all); err != nil {
+		return nil, err
+	}
+
+	view := make(map[string]json.RawMessage, len(all))
+	for key, v := range all {
+		if opts.Selects(key) {
+			view[key] = v
+		}
+	}
+	for _, rel := range opts.Expand {
+		if v, ok := all[rel]; ok {
+			view[rel] = v
+		} else {
+			view[rel] = json.RawMessage("[]")
+		}
 	}
 
-	return c.JSON(http.StatusOK, players)
+	return view, nil
 }
@@ -1,8 +1,11 @@
 package http
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -12,12 +15,23 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/xuri/excelize/v2"
 
 	playerDomain "player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/auth"
 	customErrors "player_management_system/internal/pkg/errors"
-	_ "player_management_system/internal/services/player"
+	"player_management_system/internal/pkg/validation"
+	playerService "player_management_system/internal/services/player"
+	"player_management_system/internal/services/roster"
 )
 
+// newTestEcho creates an Echo instance with the validator registered in main.
+func newTestEcho() *echo.Echo {
+	e := echo.New()
+	e.Validator = validation.New()
+	return e
+}
+
 // MockPlayerService is a mock implementation of the PlayerService interface for testing.
 type MockPlayerService struct {
 	mock.Mock
@@ -53,8 +67,68 @@ func (m *MockPlayerService) GetPlayersWithPagination(ctx context.Context, page,
 	return args.Get(0).([]*playerDomain.Player), args.Error(1)
 }
 
+func (m *MockPlayerService) GetPlayerByIDWithOptions(ctx context.Context, id uuid.UUID, opts playerDomain.ReadOptions) (*playerDomain.Player, error) {
+	args := m.Called(ctx, id, opts)
+	return args.Get(0).(*playerDomain.Player), args.Error(1)
+}
+
+func (m *MockPlayerService) GetPlayersWithOptions(ctx context.Context, page, pageSize int, filter playerDomain.PlayerFilter, opts playerDomain.ReadOptions) ([]*playerDomain.Player, error) {
+	args := m.Called(ctx, page, pageSize, filter, opts)
+	return args.Get(0).([]*playerDomain.Player), args.Error(1)
+}
+
+func (m *MockPlayerService) ExportPlayers(ctx context.Context, filter playerDomain.PlayerFilter, opts playerDomain.ReadOptions, fn func(*playerDomain.Player) error) error {
+	args := m.Called(ctx, filter, opts, fn)
+	if players, ok := args.Get(0).([]*playerDomain.Player); ok {
+		for _, p := range players {
+			if err := fn(p); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *MockPlayerService) CreatePlayers(ctx context.Context, players []*playerDomain.Player, mode playerDomain.BatchMode, upsert bool) ([]playerDomain.BatchItemStatus, error) {
+	args := m.Called(ctx, players, mode, upsert)
+	return args.Get(0).([]playerDomain.BatchItemStatus), args.Error(1)
+}
+
+func (m *MockPlayerService) GetPlayerProfile(ctx context.Context, id uuid.UUID) (*playerDomain.PlayerProfile, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*playerDomain.PlayerProfile), args.Error(1)
+}
+
+func (m *MockPlayerService) FindDuplicates(ctx context.Context, sport string, minScore float64, limit int) ([]*playerDomain.DuplicateCandidate, error) {
+	args := m.Called(ctx, sport, minScore, limit)
+	return args.Get(0).([]*playerDomain.DuplicateCandidate), args.Error(1)
+}
+
+func (m *MockPlayerService) MergePlayers(ctx context.Context, survivorID, mergedID uuid.UUID) (*playerDomain.Player, error) {
+	args := m.Called(ctx, survivorID, mergedID)
+	p, _ := args.Get(0).(*playerDomain.Player)
+	return p, args.Error(1)
+}
+
+func (m *MockPlayerService) GetPlayerRedirect(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *MockPlayerService) GetPlayerByExternalID(ctx context.Context, namespace, value string, opts playerDomain.ReadOptions) (*playerDomain.Player, error) {
+	args := m.Called(ctx, namespace, value, opts)
+	p, _ := args.Get(0).(*playerDomain.Player)
+	return p, args.Error(1)
+}
+
+func (m *MockPlayerService) GetPlayersByExternalIDs(ctx context.Context, externalIDs []string) ([]*playerDomain.Player, error) {
+	args := m.Called(ctx, externalIDs)
+	players, _ := args.Get(0).([]*playerDomain.Player)
+	return players, args.Error(1)
+}
+
 func TestCreatePlayer_Success(t *testing.T) {
-	e := echo.New()
+	e := newTestEcho()
 	req := httptest.NewRequest(http.MethodPost, "/players", strings.NewReader(`{"name":"Test Player","sport":"Football","team":"Test Team","profile_image_url":"http://example.com"}`))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
@@ -72,7 +146,7 @@ func TestCreatePlayer_Success(t *testing.T) {
 }
 
 func TestCreatePlayer_InvalidRequestBody(t *testing.T) {
-	e := echo.New()
+	e := newTestEcho()
 	req := httptest.NewRequest(http.MethodPost, "/players", strings.NewReader(`{invalid json}`))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
@@ -97,7 +171,7 @@ func TestCreatePlayer_InvalidRequestBody(t *testing.T) {
 }
 
 func TestCreatePlayer_NewPlayerError(t *testing.T) {
-	e := echo.New()
+	e := newTestEcho()
 	req := httptest.NewRequest(http.MethodPost, "/players", strings.NewReader(`{"name":"","sport":"Football","team":"Test Team","profile_image_url":"http://example.com"}`))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
@@ -120,8 +194,43 @@ func TestCreatePlayer_NewPlayerError(t *testing.T) {
 	}
 }
 
+func TestCreatePlayer_ReportsEveryInvalidField(t *testing.T) {
+	e := newTestEcho()
+	body := `{"name":"","sport":"Football","team":"","profile_image_url":"ftp://example.com/kim.png","birth_date":"2999-01-01","aliases":[{"name":"Sonny","type":"stage_name"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/players", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	handler := NewPlayerHandler(mockService)
+
+	// 실행
+	err := handler.CreatePlayer(c)
+
+	// 검증: 첫 번째 오류에서 멈추지 않고 모든 필드를 보고함
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.InvalidArgumentError, customErr.Code)
+		assert.Equal(t, "Invalid request: 5 fields are invalid", customErr.Message)
+
+		fields := make(map[string]string)
+		for _, f := range customErr.Fields {
+			fields[f.Field] = f.Message
+		}
+		assert.Equal(t, map[string]string{
+			"name":              "is required",
+			"team":              "is required",
+			"profile_image_url": "must be an absolute http or https URL without credentials, at most 2048 characters long",
+			"birth_date":        "must be a past date written as YYYY-MM-DD",
+			"aliases[0].type":   "must be one of legal, romanized, nickname, former_name",
+		}, fields)
+	}
+	mockService.AssertNotCalled(t, "CreatePlayer", mock.Anything, mock.Anything)
+}
+
 func TestCreatePlayer_CreatePlayerError(t *testing.T) {
-	e := echo.New()
+	e := newTestEcho()
 	req := httptest.NewRequest(http.MethodPost, "/players", strings.NewReader(`{"name":"Test Player","sport":"Football","team":"Test Team","profile_image_url":"http://example.com"}`))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
@@ -147,7 +256,7 @@ func TestCreatePlayer_CreatePlayerError(t *testing.T) {
 
 func TestGetPlayer_Success(t *testing.T) {
 	playerId := uuid.New()
-	e := echo.New()
+	e := newTestEcho()
 	req := httptest.NewRequest(http.MethodGet, "/players/"+playerId.String(), nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
@@ -160,7 +269,7 @@ func TestGetPlayer_Success(t *testing.T) {
 		ID:   playerId,
 		Name: "Test Player",
 	}
-	mockService.On("GetPlayerByID", mock.Anything, playerId).Return(expectedPlayer, nil)
+	mockService.On("GetPlayerByIDWithOptions", mock.Anything, playerId, playerDomain.ReadOptions{Expand: []string{playerDomain.ExpandAliases}}).Return(expectedPlayer, nil)
 
 	handler := NewPlayerHandler(mockService)
 
@@ -171,7 +280,7 @@ func TestGetPlayer_Success(t *testing.T) {
 }
 
 func TestGetPlayer_InvalidID(t *testing.T) {
-	e := echo.New()
+	e := newTestEcho()
 	req := httptest.NewRequest(http.MethodGet, "/players/invalid-id", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
@@ -198,7 +307,7 @@ func TestGetPlayer_InvalidID(t *testing.T) {
 
 func TestGetPlayer_PlayerNotFound(t *testing.T) {
 	playerId := uuid.New()
-	e := echo.New()
+	e := newTestEcho()
 	req := httptest.NewRequest(http.MethodGet, "/players/"+playerId.String(), nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
@@ -207,7 +316,8 @@ func TestGetPlayer_PlayerNotFound(t *testing.T) {
 	c.SetParamValues(playerId.String())
 
 	mockService := new(MockPlayerService)
-	mockService.On("GetPlayerByID", mock.Anything, playerId).Return((*playerDomain.Player)(nil), customErrors.NewError(customErrors.NotFoundError, "player not found"))
+	mockService.On("GetPlayerByIDWithOptions", mock.Anything, playerId, playerDomain.ReadOptions{Expand: []string{playerDomain.ExpandAliases}}).Return((*playerDomain.Player)(nil), customErrors.NewError(customErrors.NotFoundError, "player not found"))
+	mockService.On("GetPlayerRedirect", mock.Anything, playerId).Return(uuid.Nil, customErrors.NewError(customErrors.NotFoundError, "player not found"))
 	handler := NewPlayerHandler(mockService)
 
 	// 실행
@@ -226,7 +336,7 @@ func TestGetPlayer_PlayerNotFound(t *testing.T) {
 
 func TestGetPlayer_InternalError(t *testing.T) {
 	playerId := uuid.New()
-	e := echo.New()
+	e := newTestEcho()
 	req := httptest.NewRequest(http.MethodGet, "/players/"+playerId.String(), nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
@@ -235,7 +345,7 @@ func TestGetPlayer_InternalError(t *testing.T) {
 	c.SetParamValues(playerId.String())
 
 	mockService := new(MockPlayerService)
-	mockService.On("GetPlayerByID", mock.Anything, playerId).Return((*playerDomain.Player)(nil), customErrors.NewError(customErrors.InternalError, "internal error"))
+	mockService.On("GetPlayerByIDWithOptions", mock.Anything, playerId, playerDomain.ReadOptions{Expand: []string{playerDomain.ExpandAliases}}).Return((*playerDomain.Player)(nil), customErrors.NewError(customErrors.InternalError, "internal error"))
 	handler := NewPlayerHandler(mockService)
 
 	// 실행
@@ -252,8 +362,63 @@ func TestGetPlayer_InternalError(t *testing.T) {
 	}
 }
 
+func TestGetPlayerProfile_Success(t *testing.T) {
+	playerId := uuid.New()
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/"+playerId.String()+"/profile", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id/profile")
+	c.SetParamNames("id")
+	c.SetParamValues(playerId.String())
+
+	mockService := new(MockPlayerService)
+	expectedProfile := &playerDomain.PlayerProfile{
+		Player:      &playerDomain.Player{ID: playerId, Name: "Test Player"},
+		RecentMedia: []*playerDomain.Media{},
+	}
+	mockService.On("GetPlayerProfile", mock.Anything, playerId).Return(expectedProfile, nil)
+
+	handler := NewPlayerHandler(mockService)
+
+	// Assertions
+	if assert.NoError(t, handler.GetPlayerProfile(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"recent_media":[]`)
+	}
+}
+
+func TestGetPlayerProfile_PlayerNotFound(t *testing.T) {
+	playerId := uuid.New()
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/"+playerId.String()+"/profile", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id/profile")
+	c.SetParamNames("id")
+	c.SetParamValues(playerId.String())
+
+	mockService := new(MockPlayerService)
+	mockService.On("GetPlayerProfile", mock.Anything, playerId).Return((*playerDomain.PlayerProfile)(nil), customErrors.NewError(customErrors.NotFoundError, "player not found"))
+	mockService.On("GetPlayerRedirect", mock.Anything, playerId).Return(uuid.Nil, customErrors.NewError(customErrors.NotFoundError, "player not found"))
+	handler := NewPlayerHandler(mockService)
+
+	// 실행
+	err := handler.GetPlayerProfile(c)
+
+	// 검증
+	assert.Error(t, err)
+
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		assert.Equal(t, http.StatusNotFound, httpErr.Code)
+	} else {
+		assert.Fail(t, "Expected *echo.HTTPError")
+	}
+}
+
 func TestGetPlayers_Success(t *testing.T) {
-	e := echo.New()
+	e := newTestEcho()
 	req := httptest.NewRequest(http.MethodGet, "/players?page=1&size=10", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
@@ -275,7 +440,7 @@ func TestGetPlayers_Success(t *testing.T) {
 			ProfileImageURL: "http://example.com/image2.jpg",
 		},
 	}
-	mockService.On("GetPlayersWithPagination", mock.Anything, 1, 10).Return(expectedPlayers, nil)
+	mockService.On("GetPlayersWithOptions", mock.Anything, 1, 10, playerDomain.PlayerFilter{}, playerDomain.ReadOptions{}).Return(expectedPlayers, nil)
 
 	handler := NewPlayerHandler(mockService)
 
@@ -286,14 +451,14 @@ func TestGetPlayers_Success(t *testing.T) {
 }
 
 func TestGetPlayers_InvalidPage(t *testing.T) {
-	e := echo.New()
+	e := newTestEcho()
 	req := httptest.NewRequest(http.MethodGet, "/players?page=invalid&size=10", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
 	mockService := new(MockPlayerService)
 	// Page가 유효하지 않은 경우, 기본값으로 page=1, size=10을 사용하도록 설정
-	mockService.On("GetPlayersWithPagination", mock.Anything, 1, 10).Return([]*playerDomain.Player{}, nil)
+	mockService.On("GetPlayersWithOptions", mock.Anything, 1, 10, playerDomain.PlayerFilter{}, playerDomain.ReadOptions{}).Return([]*playerDomain.Player{}, nil)
 	handler := NewPlayerHandler(mockService)
 
 	// Assertions
@@ -302,14 +467,14 @@ func TestGetPlayers_InvalidPage(t *testing.T) {
 }
 
 func TestGetPlayers_InvalidSize(t *testing.T) {
-	e := echo.New()
+	e := newTestEcho()
 	req := httptest.NewRequest(http.MethodGet, "/players?page=1&size=invalid", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
 	mockService := new(MockPlayerService)
 	// Size가 유효하지 않은 경우, 기본값으로 page=1, size=10을 사용하도록 설정
-	mockService.On("GetPlayersWithPagination", mock.Anything, 1, 10).Return([]*playerDomain.Player{}, nil)
+	mockService.On("GetPlayersWithOptions", mock.Anything, 1, 10, playerDomain.PlayerFilter{}, playerDomain.ReadOptions{}).Return([]*playerDomain.Player{}, nil)
 	handler := NewPlayerHandler(mockService)
 
 	// Assertions
@@ -318,13 +483,13 @@ func TestGetPlayers_InvalidSize(t *testing.T) {
 }
 
 func TestGetPlayers_ServiceError(t *testing.T) {
-	e := echo.New()
+	e := newTestEcho()
 	req := httptest.NewRequest(http.MethodGet, "/players?page=1&size=10", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
 	mockService := new(MockPlayerService)
-	mockService.On("GetPlayersWithPagination", mock.Anything, 1, 10).Return([]*playerDomain.Player{}, customErrors.NewError(customErrors.DatabaseError, "database error"))
+	mockService.On("GetPlayersWithOptions", mock.Anything, 1, 10, playerDomain.PlayerFilter{}, playerDomain.ReadOptions{}).Return([]*playerDomain.Player{}, customErrors.NewError(customErrors.DatabaseError, "database error"))
 	handler := NewPlayerHandler(mockService)
 
 	// 실행
@@ -340,3 +505,729 @@ func TestGetPlayers_ServiceError(t *testing.T) {
 		assert.Fail(t, "Expected *echo.HTTPError")
 	}
 }
+
+func TestGetPlayer_SparseFieldsAndExpand(t *testing.T) {
+	playerId := uuid.New()
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/"+playerId.String()+"?fields=id,name&expand=media", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id")
+	c.SetParamNames("id")
+	c.SetParamValues(playerId.String())
+
+	mockService := new(MockPlayerService)
+	opts := playerDomain.ReadOptions{Fields: []string{"id", "name"}, Expand: []string{"media", "aliases"}}
+	mockService.On("GetPlayerByIDWithOptions", mock.Anything, playerId, opts).Return(&playerDomain.Player{ID: playerId, Name: "Test Player"}, nil)
+
+	handler := NewPlayerHandler(mockService)
+
+	// Assertions
+	if assert.NoError(t, handler.GetPlayer(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"id":"`+playerId.String()+`","name":"Test Player","media":[],"aliases":[]}`, rec.Body.String())
+	}
+}
+
+func TestGetPlayers_InvalidFields(t *testing.T) {
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players?fields=id,password", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	handler := NewPlayerHandler(mockService)
+
+	// 실행
+	err := handler.GetPlayers(c)
+
+	// 검증
+	assert.Error(t, err)
+
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	} else {
+		assert.Fail(t, "Expected *echo.HTTPError")
+	}
+	mockService.AssertNotCalled(t, "GetPlayersWithOptions", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBatchCreatePlayers_Atomic(t *testing.T) {
+	e := newTestEcho()
+	body := `{"players":[{"name":"Player 1","sport":"Football","team":"Team A"},{"name":"Player 2","sport":"Football","team":"Team A"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/players:batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	statuses := []playerDomain.BatchItemStatus{playerDomain.BatchItemCreated, playerDomain.BatchItemCreated}
+	mockService.On("CreatePlayers", mock.Anything, mock.AnythingOfType("[]*player.Player"), playerDomain.BatchModeAtomic, false).Return(statuses, nil)
+
+	handler := NewPlayerHandler(mockService)
+
+	// Assertions
+	if assert.NoError(t, handler.BatchCreatePlayers(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"index":1,"status":201,"result":"created"`)
+	}
+}
+
+func TestBatchCreatePlayers_AtomicInvalidItem(t *testing.T) {
+	e := newTestEcho()
+	body := `{"players":[{"name":"Player 1","sport":"Football","team":"Team A"},{"name":"","sport":"Football","team":"Team A"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/players:batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	handler := NewPlayerHandler(mockService)
+
+	// Assertions
+	if assert.NoError(t, handler.BatchCreatePlayers(c)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"index":1,"status":400,"result":"invalid"`)
+	}
+	mockService.AssertNotCalled(t, "CreatePlayers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBatchCreatePlayers_PartialMultiStatus(t *testing.T) {
+	e := newTestEcho()
+	body := `{"mode":"partial","players":[{"name":"","sport":"Football","team":"Team A"},{"name":"Player 2","sport":"Football","team":"Team A","external_id":"ext-2"},{"name":"Player 3","sport":"Football","team":"Team A"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/players:batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	statuses := []playerDomain.BatchItemStatus{playerDomain.BatchItemConflict, playerDomain.BatchItemCreated}
+	mockService.On("CreatePlayers", mock.Anything, mock.AnythingOfType("[]*player.Player"), playerDomain.BatchModePartial, false).Return(statuses, nil)
+
+	handler := NewPlayerHandler(mockService)
+
+	// Assertions
+	if assert.NoError(t, handler.BatchCreatePlayers(c)) {
+		assert.Equal(t, http.StatusMultiStatus, rec.Code)
+
+		var resp BatchCreatePlayersResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		if assert.Len(t, resp.Results, 3) {
+			assert.Equal(t, http.StatusBadRequest, resp.Results[0].Status)
+			assert.Equal(t, http.StatusConflict, resp.Results[1].Status)
+			assert.Equal(t, http.StatusCreated, resp.Results[2].Status)
+		}
+	}
+}
+
+func TestBatchCreatePlayers_UpsertRequiresExternalID(t *testing.T) {
+	e := newTestEcho()
+	body := `{"upsert":true,"players":[{"name":"Player 1","sport":"Football","team":"Team A"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/players:batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	handler := NewPlayerHandler(mockService)
+
+	// Assertions
+	if assert.NoError(t, handler.BatchCreatePlayers(c)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "external_id")
+	}
+}
+
+func TestBatchCreatePlayers_LocalizedItemErrors(t *testing.T) {
+	e := newTestEcho()
+	body := `{"mode":"partial","players":[{"name":"Player 1","sport":"Football","team":"Team A","external_id":"ext-1"},{"name":"Player 2","sport":"Football","team":"Team A","external_id":"ext-1"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/players:batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("Accept-Language", "ko")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	statuses := []playerDomain.BatchItemStatus{playerDomain.BatchItemCreated}
+	mockService.On("CreatePlayers", mock.Anything, mock.AnythingOfType("[]*player.Player"), playerDomain.BatchModePartial, false).Return(statuses, nil)
+	handler := NewPlayerHandler(mockService)
+
+	// Assertions
+	if assert.NoError(t, handler.BatchCreatePlayers(c)) {
+		assert.Equal(t, http.StatusMultiStatus, rec.Code)
+
+		var resp BatchCreatePlayersResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		if assert.Len(t, resp.Results, 2) && assert.NotNil(t, resp.Results[1].Error) {
+			// 항목 오류도 요청 언어의 문제 상세 형식으로 보고함
+			problem := resp.Results[1].Error
+			assert.Equal(t, "about:blank", problem.Type)
+			assert.Equal(t, "잘못된 요청", problem.Title)
+			assert.Equal(t, customErrors.InvalidArgumentError, problem.Code)
+			if assert.Len(t, problem.Errors, 1) {
+				assert.Equal(t, "external_id", problem.Errors[0].Field)
+				assert.Equal(t, "배치의 0번 항목에서 이미 사용되었습니다", problem.Errors[0].Message)
+			}
+		}
+	}
+}
+
+func TestBatchCreatePlayers_ChecksProfileImageURLs(t *testing.T) {
+	e := newTestEcho()
+	body := `{"mode":"partial","players":[` +
+		`{"name":"Player 1","sport":"Football","team":"Team A","profile_image_url":"https://example.com/1.jpg"},` +
+		`{"name":"Player 2","sport":"Football","team":"Team A","profile_image_url":"https://internal.example.com/2.jpg"},` +
+		`{"name":"Player 3","sport":"Football","team":"Team A"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/players:batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	statuses := []playerDomain.BatchItemStatus{playerDomain.BatchItemCreated, playerDomain.BatchItemCreated}
+	mockService.On("CreatePlayers", mock.Anything, mock.AnythingOfType("[]*player.Player"), playerDomain.BatchModePartial, false).Return(statuses, nil)
+	mockRemote := new(MockRemoteImageService)
+	mockRemote.On("CheckURL", mock.Anything, uuid.Nil, "https://example.com/1.jpg").Return(nil)
+	mockRemote.On("CheckURL", mock.Anything, uuid.Nil, "https://internal.example.com/2.jpg").
+		Return(customErrors.NewFieldError("profile_image_url", customErrors.MsgForbiddenAddress))
+	mockRemote.On("Mirror", mock.Anything, mock.AnythingOfType("uuid.UUID"), mock.Anything).Return()
+	handler := NewPlayerHandler(mockService, WithRemoteImages(mockRemote))
+
+	// Assertions
+	if assert.NoError(t, handler.BatchCreatePlayers(c)) {
+		var resp BatchCreatePlayersResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		if assert.Len(t, resp.Results, 3) {
+			assert.Equal(t, http.StatusCreated, resp.Results[0].Status)
+			assert.Equal(t, http.StatusBadRequest, resp.Results[1].Status)
+			assert.Equal(t, http.StatusCreated, resp.Results[2].Status)
+		}
+	}
+	// URL이 없는 항목은 확인하지 않음
+	mockRemote.AssertNumberOfCalls(t, "CheckURL", 2)
+}
+
+func TestImportRoster_DryRun(t *testing.T) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "roster.csv")
+	assert.NoError(t, err)
+	_, err = part.Write([]byte("이름,종목,팀\n김도영,야구,기아\n"))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.WriteField("mapping", `{"name":"이름","sport":"종목","team":"팀"}`))
+	assert.NoError(t, writer.Close())
+
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodPost, "/players/import", &body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	req = req.WithContext(auth.WithPrincipal(req.Context(), &auth.Principal{Subject: "editor", Roles: []string{auth.RoleEditor}}))
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	handler := NewRosterImportHandler(roster.NewImporter(mockService))
+
+	// Assertions
+	if assert.NoError(t, handler.ImportRoster(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"dry_run":true`)
+		assert.Contains(t, rec.Body.String(), `"valid_rows":1`)
+	}
+	mockService.AssertNotCalled(t, "CreatePlayers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImportRoster_TooLarge(t *testing.T) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "roster.csv")
+	assert.NoError(t, err)
+	_, err = part.Write(bytes.Repeat([]byte("김도영,야구,기아\n"), maxRosterFileSize/20))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodPost, "/players/import", &body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	req = req.WithContext(auth.WithPrincipal(req.Context(), &auth.Principal{Subject: "editor", Roles: []string{auth.RoleEditor}}))
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	handler := NewRosterImportHandler(roster.NewImporter(new(MockPlayerService)))
+
+	// 파일 전체를 파싱하기 전에 본문 크기에서 거부됨
+	err = handler.ImportRoster(c)
+	var httpErr *echo.HTTPError
+	if assert.ErrorAs(t, err, &httpErr) {
+		assert.Equal(t, http.StatusRequestEntityTooLarge, httpErr.Code)
+	}
+}
+
+func TestImportRoster_ForbiddenBeforeUpload(t *testing.T) {
+	body := strings.NewReader("--x\r\nContent-Disposition: form-data; name=\"file\"; filename=\"roster.csv\"\r\n\r\nname,sport,team\r\n--x--\r\n")
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodPost, "/players/import", body)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEMultipartForm+"; boundary=x")
+	req = req.WithContext(auth.WithPrincipal(req.Context(), &auth.Principal{Subject: "viewer", Roles: []string{auth.RoleViewer}}))
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	handler := NewRosterImportHandler(roster.NewImporter(new(MockPlayerService)))
+
+	err := handler.ImportRoster(c)
+	var httpErr *echo.HTTPError
+	if assert.ErrorAs(t, err, &httpErr) {
+		assert.Equal(t, http.StatusForbidden, httpErr.Code)
+	}
+	// 권한이 없으면 업로드된 본문을 읽지 않음
+	assert.Equal(t, body.Size(), int64(body.Len()))
+}
+
+func TestGetPlayers_Filter(t *testing.T) {
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players?sport=%EC%95%BC%EA%B5%AC&team=%EA%B8%B0%EC%95%84&name=%EA%B9%80", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	filter := playerDomain.PlayerFilter{Name: "김", Sport: "야구", Team: "기아"}
+	mockService.On("GetPlayersWithOptions", mock.Anything, 1, 10, filter, playerDomain.ReadOptions{}).Return([]*playerDomain.Player{}, nil)
+	handler := NewPlayerHandler(mockService)
+
+	// Assertions
+	assert.NoError(t, handler.GetPlayers(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestExportPlayers_CSV(t *testing.T) {
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/export?format=csv&fields=name,team&sport=Football", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	players := []*playerDomain.Player{
+		{ID: uuid.New(), Name: "Test Player 1", Team: "Team A"},
+		{ID: uuid.New(), Name: "Test Player 2", Team: "Team B"},
+	}
+	opts := playerDomain.ReadOptions{Fields: []string{"name", "team"}}
+	mockService.On("ExportPlayers", mock.Anything, playerDomain.PlayerFilter{Sport: "Football"}, opts, mock.Anything).Return(players, nil)
+	handler := NewPlayerHandler(mockService)
+
+	// Assertions
+	if assert.NoError(t, handler.ExportPlayers(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "attachment; filename=\"players-")
+		assert.Equal(t, "name,team\nTest Player 1,Team A\nTest Player 2,Team B\n", rec.Body.String())
+	}
+}
+
+func TestExportPlayers_NDJSON(t *testing.T) {
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/export?format=ndjson&fields=name", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	players := []*playerDomain.Player{{Name: "Test Player 1"}, {Name: "Test Player 2"}}
+	mockService.On("ExportPlayers", mock.Anything, playerDomain.PlayerFilter{}, playerDomain.ReadOptions{Fields: []string{"name"}}, mock.Anything).Return(players, nil)
+	handler := NewPlayerHandler(mockService)
+
+	// Assertions
+	if assert.NoError(t, handler.ExportPlayers(c)) {
+		assert.Equal(t, "application/x-ndjson", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "{\"name\":\"Test Player 1\"}\n{\"name\":\"Test Player 2\"}\n", rec.Body.String())
+	}
+}
+
+func TestExportPlayers_XLSX(t *testing.T) {
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/export?format=xlsx", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	players := []*playerDomain.Player{{ID: uuid.New(), Name: "Test Player 1"}}
+	mockService.On("ExportPlayers", mock.Anything, playerDomain.PlayerFilter{}, playerDomain.ReadOptions{}, mock.Anything).Return(players, nil)
+	handler := NewPlayerHandler(mockService)
+
+	// Assertions
+	if assert.NoError(t, handler.ExportPlayers(c)) {
+		f, err := excelize.OpenReader(rec.Body)
+		if assert.NoError(t, err) {
+			rows, err := f.GetRows(f.GetSheetName(0))
+			assert.NoError(t, err)
+			assert.Len(t, rows, 2)
+			assert.Equal(t, "Test Player 1", rows[1][1])
+		}
+	}
+}
+
+func TestExportPlayers_FailsBeforeFirstRow(t *testing.T) {
+	for _, format := range []string{"csv", "ndjson", "xlsx"} {
+		t.Run(format, func(t *testing.T) {
+			e := newTestEcho()
+			req := httptest.NewRequest(http.MethodGet, "/players/export?format="+format, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockService := new(MockPlayerService)
+			dbErr := customErrors.NewError(customErrors.DatabaseError, "connection reset")
+			mockService.On("ExportPlayers", mock.Anything, playerDomain.PlayerFilter{}, playerDomain.ReadOptions{}, mock.Anything).Return(nil, dbErr)
+			handler := NewPlayerHandler(mockService)
+
+			// 파일을 보내기 전의 오류는 문제 상세로 응답할 수 있음
+			err := handler.ExportPlayers(c)
+			assert.ErrorIs(t, err, dbErr)
+			assert.False(t, c.Response().Committed)
+			assert.Empty(t, rec.Header().Get(echo.HeaderContentDisposition))
+		})
+	}
+}
+
+func TestExportPlayers_Forbidden(t *testing.T) {
+	for _, format := range []string{"csv", "ndjson", "xlsx"} {
+		t.Run(format, func(t *testing.T) {
+			e := newTestEcho()
+			e.HTTPErrorHandler = customErrors.HTTPErrorHandler
+			// 권한 검사는 서비스에서 하므로 저장소까지 가지 않음
+			handler := NewPlayerHandler(playerService.NewPlayerService(nil))
+			e.GET("/players/export", handler.ExportPlayers, func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					principal := &auth.Principal{Subject: "partner", APIKeyID: "key-1", Scopes: []string{auth.ScopeMediaWrite}}
+					c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(), principal)))
+					return next(c)
+				}
+			})
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/players/export?format="+format, nil))
+
+			// 빈 파일 대신 403 문제 상세로 응답함
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+			assert.Equal(t, customErrors.MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+			assert.Empty(t, rec.Header().Get(echo.HeaderContentDisposition))
+			assert.Contains(t, rec.Body.String(), `"code":"Forbidden"`)
+		})
+	}
+}
+
+func TestExportPlayers_AbortsAfterFirstRow(t *testing.T) {
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/export?format=ndjson", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	players := []*playerDomain.Player{{ID: uuid.New(), Name: "Test Player 1"}}
+	dbErr := customErrors.NewError(customErrors.DatabaseError, "connection reset")
+	mockService.On("ExportPlayers", mock.Anything, playerDomain.PlayerFilter{}, playerDomain.ReadOptions{}, mock.Anything).Return(players, dbErr)
+	handler := NewPlayerHandler(mockService)
+
+	// 보내기 시작한 파일은 연결을 끊어 잘렸음을 알림
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		_ = handler.ExportPlayers(c)
+	})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "attachment")
+}
+
+func TestExportPlayers_InvalidFormat(t *testing.T) {
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/export?format=pdf", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler := NewPlayerHandler(new(MockPlayerService))
+
+	// 실행
+	err := handler.ExportPlayers(c)
+
+	// 검증
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	} else {
+		assert.Fail(t, "Expected *echo.HTTPError")
+	}
+}
+
+func TestGetPlayer_MergedRedirects(t *testing.T) {
+	mergedID, survivorID := uuid.New(), uuid.New()
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/"+mergedID.String()+"?fields=id,name", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id")
+	c.SetParamNames("id")
+	c.SetParamValues(mergedID.String())
+
+	mockService := new(MockPlayerService)
+	mockService.On("GetPlayerByIDWithOptions", mock.Anything, mergedID, mock.Anything).Return((*playerDomain.Player)(nil), customErrors.NewError(customErrors.NotFoundError, "player not found"))
+	mockService.On("GetPlayerRedirect", mock.Anything, mergedID).Return(survivorID, nil)
+	handler := NewPlayerHandler(mockService)
+
+	// 실행
+	err := handler.GetPlayer(c)
+
+	// 검증
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+	assert.Equal(t, "/players/"+survivorID.String()+"?fields=id,name", rec.Header().Get(echo.HeaderLocation))
+}
+
+func TestFindDuplicates(t *testing.T) {
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/duplicates?sport=야구&min_score=0.9", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	candidates := []*playerDomain.DuplicateCandidate{{
+		Player:    &playerDomain.Player{ID: uuid.New(), Name: "김도영"},
+		Duplicate: &playerDomain.Player{ID: uuid.New(), Name: "김 도영"},
+		Score:     0.9,
+		Reasons:   []string{playerDomain.DuplicateReasonName, playerDomain.DuplicateReasonTeam},
+	}}
+	mockService.On("FindDuplicates", mock.Anything, "야구", 0.9, defaultDuplicateLimit).Return(candidates, nil)
+	handler := NewPlayerHandler(mockService)
+
+	// 실행
+	err := handler.FindDuplicates(c)
+
+	// 검증
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"score":0.9`)
+	mockService.AssertExpectations(t)
+
+	req = httptest.NewRequest(http.MethodGet, "/players/duplicates?min_score=2", nil)
+	c = e.NewContext(req, httptest.NewRecorder())
+	var httpErr *echo.HTTPError
+	if assert.ErrorAs(t, handler.FindDuplicates(c), &httpErr) {
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	}
+}
+
+func TestFindDuplicates_LocalizedError(t *testing.T) {
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/duplicates?limit=0", nil)
+	req.Header.Set("Accept-Language", "ko")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler := NewPlayerHandler(new(MockPlayerService))
+
+	// 실행
+	customErrors.HTTPErrorHandler(handler.FindDuplicates(c), c)
+
+	// 검증
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"field":"limit","message":"1 이상 500 이하여야 합니다"`)
+	assert.Contains(t, rec.Body.String(), "잘못된 입력: 최대 개수")
+}
+
+func TestMergePlayers(t *testing.T) {
+	survivorID, mergedID := uuid.New(), uuid.New()
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodPost, "/players/"+survivorID.String()+"/merge", strings.NewReader(`{"duplicate_id":"`+mergedID.String()+`"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id/merge")
+	c.SetParamNames("id")
+	c.SetParamValues(survivorID.String())
+
+	mockService := new(MockPlayerService)
+	survivor := &playerDomain.Player{ID: survivorID, Name: "김도영"}
+	mockService.On("MergePlayers", mock.Anything, survivorID, mergedID).Return(survivor, nil)
+	handler := NewPlayerHandler(mockService)
+
+	// 실행
+	err := handler.MergePlayers(c)
+
+	// 검증
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), survivorID.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestGetPlayerByExternalID(t *testing.T) {
+	playerID := uuid.New()
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/by-external-id/wikidata/Q42?expand=external_ids", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/by-external-id/:namespace/:value")
+	c.SetParamNames("namespace", "value")
+	c.SetParamValues("wikidata", "Q42")
+
+	mockService := new(MockPlayerService)
+	p := &playerDomain.Player{
+		ID:          playerID,
+		Name:        "김도영",
+		ExternalIDs: []*playerDomain.ExternalIdentifier{{PlayerID: playerID, Namespace: "wikidata", Value: "Q42"}},
+	}
+	opts := playerDomain.ReadOptions{Expand: []string{playerDomain.ExpandExternalIDs}}
+	mockService.On("GetPlayerByExternalID", mock.Anything, "wikidata", "Q42", opts).Return(p, nil)
+	handler := NewPlayerHandler(mockService)
+
+	// 실행
+	err := handler.GetPlayerByExternalID(c)
+
+	// 검증
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"external_ids":[{"namespace":"wikidata","value":"Q42"}]`)
+	mockService.AssertExpectations(t)
+}
+
+func TestGetPlayerByExternalID_InvalidQID(t *testing.T) {
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/by-external-id/wikidata/42", nil)
+	c := e.NewContext(req, httptest.NewRecorder())
+	c.SetPath("/players/by-external-id/:namespace/:value")
+	c.SetParamNames("namespace", "value")
+	c.SetParamValues("wikidata", "42")
+
+	handler := NewPlayerHandler(new(MockPlayerService))
+
+	// 실행
+	err := handler.GetPlayerByExternalID(c)
+
+	// 검증
+	var httpErr *echo.HTTPError
+	if assert.ErrorAs(t, err, &httpErr) {
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	}
+}
+
+func TestCreatePlayer_DuplicateExternalIDNamespace(t *testing.T) {
+	e := newTestEcho()
+	body := `{"name":"Test Player","sport":"Baseball","team":"Test Team","external_ids":[{"namespace":"league","value":"1"},{"namespace":"league","value":"2"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/players", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	handler := NewPlayerHandler(new(MockPlayerService))
+
+	// 실행
+	err := handler.CreatePlayer(c)
+
+	// 검증
+	var httpErr *echo.HTTPError
+	if assert.ErrorAs(t, err, &httpErr) {
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	}
+}
+
+func TestUpdatePlayer_ExternalIDs(t *testing.T) {
+	playerID := uuid.New()
+	e := newTestEcho()
+	body := `{"name":"Test Player","sport":"Baseball","team":"Test Team","external_ids":[{"namespace":"league","value":"KBO-1001"}]}`
+	req := httptest.NewRequest(http.MethodPut, "/players/"+playerID.String(), strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id")
+	c.SetParamNames("id")
+	c.SetParamValues(playerID.String())
+
+	mockService := new(MockPlayerService)
+	mockService.On("UpdatePlayer", mock.Anything, mock.MatchedBy(func(p *playerDomain.Player) bool {
+		return p.ID == playerID && len(p.ExternalIDs) == 1 && p.ExternalIDs[0].Value == "KBO-1001"
+	})).Return(nil)
+	updated := &playerDomain.Player{ID: playerID, Name: "Test Player"}
+	mockService.On("GetPlayerByIDWithOptions", mock.Anything, playerID, playerDomain.ReadOptions{Expand: []string{playerDomain.ExpandExternalIDs, playerDomain.ExpandAliases}}).Return(updated, nil)
+	handler := NewPlayerHandler(mockService)
+
+	// 실행
+	err := handler.UpdatePlayer(c)
+
+	// 검증
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestUpdatePlayer_ExternalIDConflict(t *testing.T) {
+	playerID := uuid.New()
+	e := newTestEcho()
+	body := `{"name":"Test Player","sport":"Baseball","team":"Test Team","external_ids":[{"namespace":"league","value":"KBO-1001"}]}`
+	req := httptest.NewRequest(http.MethodPut, "/players/"+playerID.String(), strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, httptest.NewRecorder())
+	c.SetPath("/players/:id")
+	c.SetParamNames("id")
+	c.SetParamValues(playerID.String())
+
+	mockService := new(MockPlayerService)
+	mockService.On("UpdatePlayer", mock.Anything, mock.Anything).Return(customErrors.NewError(customErrors.AlreadyExistsError, "external ID is already linked to another player"))
+	handler := NewPlayerHandler(mockService)
+
+	// 실행
+	err := handler.UpdatePlayer(c)
+
+	// 검증
+	var httpErr *echo.HTTPError
+	if assert.ErrorAs(t, err, &httpErr) {
+		assert.Equal(t, http.StatusConflict, httpErr.Code)
+	}
+}
+
+func TestGetPlayer_Aliases(t *testing.T) {
+	playerId := uuid.New()
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/"+playerId.String(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id")
+	c.SetParamNames("id")
+	c.SetParamValues(playerId.String())
+
+	mockService := new(MockPlayerService)
+	p := &playerDomain.Player{
+		ID:      playerId,
+		Name:    "김도영",
+		Aliases: []*playerDomain.Alias{{Name: "도니", Type: playerDomain.AliasTypeNickname, Locale: "ko"}},
+	}
+	mockService.On("GetPlayerByIDWithOptions", mock.Anything, playerId, playerDomain.ReadOptions{Expand: []string{playerDomain.ExpandAliases}}).Return(p, nil)
+	handler := NewPlayerHandler(mockService)
+
+	// 실행
+	err := handler.GetPlayer(c)
+
+	// 검증
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"aliases":[{"id":"00000000-0000-0000-0000-000000000000","name":"도니","type":"nickname","locale":"ko","primary":false}]`)
+}
+
+func TestCreatePlayer_Romanize(t *testing.T) {
+	e := newTestEcho()
+	body := `{"name":"김도영","sport":"야구","team":"기아","aliases":[{"name":"도니","type":"nickname","locale":"ko"}],"romanize":true}`
+	req := httptest.NewRequest(http.MethodPost, "/players", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService := new(MockPlayerService)
+	var created *playerDomain.Player
+	mockService.On("CreatePlayer", mock.Anything, mock.AnythingOfType("*player.Player")).
+		Run(func(args mock.Arguments) { created = args.Get(1).(*playerDomain.Player) }).
+		Return(nil)
+	handler := NewPlayerHandler(mockService)
+
+	// 실행
+	err := handler.CreatePlayer(c)
+
+	// 검증
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	if assert.Len(t, created.Aliases, 3) {
+		assert.Equal(t, "도니", created.Aliases[0].Name)
+		assert.Equal(t, "Gim Do-yeong", created.Aliases[1].Name)
+		assert.Equal(t, "Kim Do-yeong", created.Aliases[2].Name)
+	}
+}
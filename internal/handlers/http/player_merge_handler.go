@@ -0,0 +1,93 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	playerDomain "player_management_system/internal/domains/players"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+const (
+	// defaultDuplicateLimit and maxDuplicateLimit bound the number of duplicate pairs returned.
+	defaultDuplicateLimit = 50
+	maxDuplicateLimit     = 500
+)
+
+// MergePlayersRequest represents the request body of POST /players/:id/merge.
+type MergePlayersRequest struct {
+	// DuplicateID is the player folded into the surviving player named in the path.
+	DuplicateID string `json:"duplicate_id" validate:"required,uuid"`
+}
+
+// FindDuplicates handles the GET /players/duplicates request.
+// It supports ?sport= to restrict the search, ?min_score= between 0 and 1 and ?limit=.
+func (h *PlayerHandler) FindDuplicates(c echo.Context) error {
+	minScore := playerDomain.DefaultDuplicateScore
+	if s := c.QueryParam("min_score"); s != "" {
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil || v < 0 || v > 1 {
+			return customErrors.NewHTTPError(customErrors.NewFieldError("min_score", customErrors.MsgBetween, 0, 1))
+		}
+		minScore = v
+	}
+
+	limit := defaultDuplicateLimit
+	if s := c.QueryParam("limit"); s != "" {
+		v, err := strconv.Atoi(s)
+		if err != nil || v < 1 || v > maxDuplicateLimit {
+			return customErrors.NewHTTPError(customErrors.NewFieldError("limit", customErrors.MsgBetween, 1, maxDuplicateLimit))
+		}
+		limit = v
+	}
+
+	candidates, err := h.playerService.FindDuplicates(c.Request().Context(), c.QueryParam("sport"), minScore, limit)
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	return c.JSON(http.StatusOK, candidates)
+}
+
+// MergePlayers handles the POST /players/:id/merge request.
+// The player in the path survives; the duplicate's relations move to it and its ID redirects to it.
+func (h *PlayerHandler) MergePlayers(c echo.Context) error {
+	survivorID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return customErrors.NewHTTPError(customErrors.NewFieldError("id", customErrors.MsgInvalidUUID))
+	}
+
+	var req MergePlayersRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+	mergedID, err := uuid.Parse(req.DuplicateID)
+	if err != nil {
+		return customErrors.NewHTTPError(customErrors.NewFieldError("duplicate_id", customErrors.MsgInvalidUUID))
+	}
+
+	p, err := h.playerService.MergePlayers(c.Request().Context(), survivorID, mergedID)
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	return c.JSON(http.StatusOK, p)
+}
+
+// playerNotFound answers a request for a player that could not be loaded.
+// When the player was merged into another one, the client is redirected there, with the given
+// path suffix and the original query string; otherwise err is returned as is.
+func (h *PlayerHandler) playerNotFound(c echo.Context, id uuid.UUID, suffix string, err error) error {
+	if customErrors.GetHTTPStatusCode(err) == http.StatusNotFound {
+		if target, redirectErr := h.playerService.GetPlayerRedirect(c.Request().Context(), id); redirectErr == nil {
+			location := "/players/" + target.String() + suffix
+			if query := c.QueryString(); query != "" {
+				location += "?" + query
+			}
+			return c.Redirect(http.StatusMovedPermanently, location)
+		}
+	}
+	return customErrors.NewHTTPError(err)
+}
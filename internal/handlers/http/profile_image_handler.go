@@ -0,0 +1,94 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	customErrors "player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/imaging"
+	"player_management_system/internal/services/profileimage"
+)
+
+// multipartOverhead is the room left for multipart headers, boundaries and form fields around an uploaded file.
+const multipartOverhead = 64 << 10
+
+var acceptedImageTypes = []string{imaging.TypeJPEG, imaging.TypePNG, imaging.TypeWebP}
+
+// ProfileImageHandler handles HTTP requests for profile image uploads and remote image links.
+type ProfileImageHandler struct {
+	service      profileimage.ProfileImageService
+	remoteImages profileimage.RemoteImageService
+	maxSize      int64
+}
+
+// NewProfileImageHandler creates a new ProfileImageHandler accepting images of up to maxSize bytes.
+func NewProfileImageHandler(service profileimage.ProfileImageService, remoteImages profileimage.RemoteImageService, maxSize int64) *ProfileImageHandler {
+	return &ProfileImageHandler{service: service, remoteImages: remoteImages, maxSize: maxSize}
+}
+
+// RegisterRoutes registers the profile image routes with the Echo router.
+func (h *ProfileImageHandler) RegisterRoutes(e *echo.Echo) {
+	e.POST("/players/:id/image", h.UploadProfileImage)
+	e.GET("/players/broken-image-links", h.GetBrokenImageLinks)
+}
+
+// UploadProfileImage handles the POST /players/:id/image multipart request.
+// The image is sent in the image field as JPEG, PNG or WebP. It is stored with its thumbnails and
+// becomes the player's profile image; the stored URLs are returned with 201.
+func (h *ProfileImageHandler) UploadProfileImage(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return customErrors.NewHTTPError(customErrors.NewFieldError("id", customErrors.MsgInvalidUUID))
+	}
+
+	// 멀티파트 파싱 전에 본문 크기를 제한
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, h.maxSize+multipartOverhead)
+
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return customErrors.NewHTTPError(customErrors.NewErrorWithKey(customErrors.PayloadTooLargeError, customErrors.MsgFileTooLarge, h.maxSize>>20))
+		}
+		return customErrors.NewHTTPError(customErrors.NewFieldError("image", customErrors.MsgRequired))
+	}
+	if fileHeader.Size > h.maxSize {
+		return customErrors.NewHTTPError(customErrors.NewErrorWithKey(customErrors.PayloadTooLargeError, customErrors.MsgFileTooLarge, h.maxSize>>20))
+	}
+	if !slices.Contains(acceptedImageTypes, fileHeader.Header.Get(echo.HeaderContentType)) {
+		return customErrors.NewHTTPError(customErrors.NewErrorWithKey(customErrors.UnsupportedMediaTypeError, customErrors.MsgUnsupportedImageType))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return customErrors.NewHTTPError(customErrors.NewFieldError("image", customErrors.MsgInvalidValue))
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, h.maxSize))
+	if err != nil {
+		return customErrors.NewHTTPError(customErrors.NewFieldError("image", customErrors.MsgInvalidValue))
+	}
+
+	image, err := h.service.UploadProfileImage(c.Request().Context(), id, data)
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	return c.JSON(http.StatusCreated, image)
+}
+
+// GetBrokenImageLinks handles the GET /players/broken-image-links request.
+// It lists the remote profile image URLs that the periodic link check found broken.
+func (h *ProfileImageHandler) GetBrokenImageLinks(c echo.Context) error {
+	checks, err := h.remoteImages.GetBrokenLinks(c.Request().Context())
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	return c.JSON(http.StatusOK, checks)
+}
@@ -0,0 +1,231 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	playerDomain "player_management_system/internal/domains/players"
+	customErrors "player_management_system/internal/pkg/errors"
+	"player_management_system/internal/services/profileimage"
+)
+
+// MockProfileImageService is a mock implementation of the ProfileImageService interface for testing.
+type MockProfileImageService struct {
+	mock.Mock
+}
+
+func (m *MockProfileImageService) UploadProfileImage(ctx context.Context, playerID uuid.UUID, data []byte) (*playerDomain.ProfileImage, error) {
+	args := m.Called(ctx, playerID, data)
+	image, _ := args.Get(0).(*playerDomain.ProfileImage)
+	return image, args.Error(1)
+}
+
+// MockRemoteImageService is a mock implementation of the RemoteImageService interface for testing.
+type MockRemoteImageService struct {
+	mock.Mock
+	profileimage.RemoteImageService
+}
+
+func (m *MockRemoteImageService) CheckURL(ctx context.Context, playerID uuid.UUID, rawURL string) error {
+	args := m.Called(ctx, playerID, rawURL)
+	return args.Error(0)
+}
+
+func (m *MockRemoteImageService) Mirror(ctx context.Context, playerID uuid.UUID, url string) {
+	m.Called(ctx, playerID, url)
+}
+
+func (m *MockRemoteImageService) GetBrokenLinks(ctx context.Context) ([]*playerDomain.ProfileImageLinkCheck, error) {
+	args := m.Called(ctx)
+	checks, _ := args.Get(0).([]*playerDomain.ProfileImageLinkCheck)
+	return checks, args.Error(1)
+}
+
+// imageUploadContext builds a multipart upload of data declared with the given content type.
+func imageUploadContext(t *testing.T, playerID string, contentType string, data []byte) (echo.Context, *httptest.ResponseRecorder) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="image"; filename="profile"`)
+	header.Set("Content-Type", contentType)
+	part, err := writer.CreatePart(header)
+	assert.NoError(t, err)
+	_, _ = part.Write(data)
+	assert.NoError(t, writer.Close())
+
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodPost, "/players/"+playerID+"/image", &body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/players/:id/image")
+	c.SetParamNames("id")
+	c.SetParamValues(playerID)
+	return c, rec
+}
+
+func TestUploadProfileImage_Success(t *testing.T) {
+	playerID := uuid.New()
+	data := []byte("\x89PNG\r\n\x1a\nimage")
+	c, rec := imageUploadContext(t, playerID.String(), "image/png", data)
+
+	mockService := new(MockProfileImageService)
+	uploaded := &playerDomain.ProfileImage{PlayerID: playerID, URL: "https://img.example.com/players/1/original.png"}
+	mockService.On("UploadProfileImage", mock.Anything, playerID, data).Return(uploaded, nil)
+	handler := NewProfileImageHandler(mockService, nil, 1<<20)
+
+	// 실행
+	err := handler.UploadProfileImage(c)
+
+	// 검증
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Contains(t, rec.Body.String(), uploaded.URL)
+	mockService.AssertExpectations(t)
+}
+
+func TestUploadProfileImage_Rejected(t *testing.T) {
+	playerID := uuid.New().String()
+
+	tests := []struct {
+		name        string
+		playerID    string
+		contentType string
+		data        []byte
+		maxSize     int64
+		status      int
+	}{
+		{"invalid player ID", "invalid-id", "image/png", []byte("png"), 1 << 20, http.StatusBadRequest},
+		{"unsupported type", playerID, "image/svg+xml", []byte("<svg/>"), 1 << 20, http.StatusUnsupportedMediaType},
+		{"too large", playerID, "image/jpeg", bytes.Repeat([]byte("x"), 2048), 1024, http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := imageUploadContext(t, tt.playerID, tt.contentType, tt.data)
+			handler := NewProfileImageHandler(new(MockProfileImageService), nil, tt.maxSize)
+
+			// 실행
+			err := handler.UploadProfileImage(c)
+
+			// 검증
+			var httpErr *echo.HTTPError
+			if assert.ErrorAs(t, err, &httpErr) {
+				assert.Equal(t, tt.status, httpErr.Code)
+			}
+		})
+	}
+}
+
+func TestCreatePlayer_RemoteProfileImage(t *testing.T) {
+	body := `{"name":"김도영","sport":"야구","team":"기아","profile_image_url":"https://example.com/kim.jpg"}`
+
+	t.Run("mirrored after creation", func(t *testing.T) {
+		e := newTestEcho()
+		req := httptest.NewRequest(http.MethodPost, "/players", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService := new(MockPlayerService)
+		mockService.On("CreatePlayer", mock.Anything, mock.AnythingOfType("*player.Player")).Return(nil)
+		mockRemote := new(MockRemoteImageService)
+		mockRemote.On("CheckURL", mock.Anything, uuid.Nil, "https://example.com/kim.jpg").Return(nil)
+		mockRemote.On("Mirror", mock.Anything, mock.AnythingOfType("uuid.UUID"), "https://example.com/kim.jpg").Return()
+		handler := NewPlayerHandler(mockService, WithRemoteImages(mockRemote))
+
+		// 실행
+		err := handler.CreatePlayer(c)
+
+		// 검증
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		mockRemote.AssertExpectations(t)
+	})
+
+	t.Run("private target rejected", func(t *testing.T) {
+		e := newTestEcho()
+		req := httptest.NewRequest(http.MethodPost, "/players", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		c := e.NewContext(req, httptest.NewRecorder())
+
+		mockService := new(MockPlayerService)
+		mockRemote := new(MockRemoteImageService)
+		mockRemote.On("CheckURL", mock.Anything, uuid.Nil, "https://example.com/kim.jpg").
+			Return(customErrors.NewError(customErrors.InvalidArgumentError, "Invalid argument: profile_image_url: URL must not target a loopback, private or reserved address"))
+		handler := NewPlayerHandler(mockService, WithRemoteImages(mockRemote))
+
+		// 실행
+		err := handler.CreatePlayer(c)
+
+		// 검증
+		var httpErr *echo.HTTPError
+		if assert.ErrorAs(t, err, &httpErr) {
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+		}
+		mockService.AssertNotCalled(t, "CreatePlayer", mock.Anything, mock.Anything)
+		mockRemote.AssertNotCalled(t, "Mirror", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUpdatePlayer_StoredProfileImageOfAnotherPlayer(t *testing.T) {
+	playerID := uuid.New()
+	url := "/images/players/" + uuid.NewString() + "/abcd/original.jpg"
+	e := newTestEcho()
+	body := `{"name":"김도영","sport":"야구","team":"기아","profile_image_url":"` + url + `"}`
+	req := httptest.NewRequest(http.MethodPut, "/players/"+playerID.String(), strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, httptest.NewRecorder())
+	c.SetPath("/players/:id")
+	c.SetParamNames("id")
+	c.SetParamValues(playerID.String())
+
+	mockService := new(MockPlayerService)
+	mockRemote := new(MockRemoteImageService)
+	// 수정하는 선수를 기준으로 확인함
+	mockRemote.On("CheckURL", mock.Anything, playerID, url).
+		Return(customErrors.NewFieldError("profile_image_url", customErrors.MsgForeignStoredImage))
+	handler := NewPlayerHandler(mockService, WithRemoteImages(mockRemote))
+
+	// 실행
+	err := handler.UpdatePlayer(c)
+
+	// 검증
+	var httpErr *echo.HTTPError
+	if assert.ErrorAs(t, err, &httpErr) {
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	}
+	mockService.AssertNotCalled(t, "UpdatePlayer", mock.Anything, mock.Anything)
+}
+
+func TestGetBrokenImageLinks(t *testing.T) {
+	e := newTestEcho()
+	req := httptest.NewRequest(http.MethodGet, "/players/broken-image-links", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	check := &playerDomain.ProfileImageLinkCheck{PlayerID: uuid.New(), URL: "https://example.com/gone.jpg", StatusCode: http.StatusNotFound, Broken: true}
+	mockRemote := new(MockRemoteImageService)
+	mockRemote.On("GetBrokenLinks", mock.Anything).Return([]*playerDomain.ProfileImageLinkCheck{check}, nil)
+	handler := NewProfileImageHandler(new(MockProfileImageService), mockRemote, 1<<20)
+
+	// 실행
+	err := handler.GetBrokenImageLinks(c)
+
+	// 검증
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"url":"https://example.com/gone.jpg"`)
+	assert.Contains(t, rec.Body.String(), `"status_code":404`)
+}
@@ -0,0 +1,122 @@
+package http
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"player_management_system/internal/pkg/auth"
+	customErrors "player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/ratelimit"
+)
+
+// RateLimitConfig holds the limits applied to every client.
+// Reads (GET, HEAD and OPTIONS) and writes are counted in separate buckets.
+type RateLimitConfig struct {
+	Read  ratelimit.Limit
+	Write ratelimit.Limit
+}
+
+// RateLimit returns a middleware that limits the request rate of every client with a token bucket.
+// Clients are identified by API key, then by authenticated user, so it must run after the
+// authentication middlewares; unauthenticated requests are left to IPRateLimit. Rejected requests
+// get a 429 with Retry-After, and every response carries the RateLimit-Limit, RateLimit-Remaining
+// and RateLimit-Reset headers. When the store fails the request is let through. Health probes and
+// metrics scrapes are not limited.
+func RateLimit(store ratelimit.Store, config RateLimitConfig) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			id, ok := clientID(c)
+			if !ok || isProbe(c) {
+				return next(c)
+			}
+
+			class, limit := "write", config.Write
+			switch c.Request().Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				class, limit = "read", config.Read
+			}
+
+			return takeToken(c, store, class+":"+id, limit, next)
+		}
+	}
+}
+
+// IPRateLimit returns a middleware that limits the request rate of every IP address, whether the
+// request is authenticated or not. It must run before the authentication middlewares, so that
+// requests with missing or invalid credentials are counted before any key is looked up. The address
+// is taken from Echo's IPExtractor, which must be set with IPExtractor so that clients cannot pick it.
+// Responses are like those of RateLimit, whose headers replace these once a client is identified.
+func IPRateLimit(store ratelimit.Store, limit ratelimit.Limit) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if isProbe(c) {
+				return next(c)
+			}
+			return takeToken(c, store, "ip:"+c.RealIP(), limit, next)
+		}
+	}
+}
+
+// IPExtractor returns the IP extractor for Echo, given the CIDR ranges of the trusted reverse proxies.
+// X-Forwarded-For is only believed for the hops added by those proxies; without any, the address of
+// the connection is used, since clients can put anything in the header.
+func IPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	// 기본으로 신뢰하는 사설망과 루프백도 설정된 범위에 포함될 때만 신뢰함
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, cidr := range trustedProxies {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy range %q: %w", cidr, err)
+		}
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
+// takeToken takes a token from the bucket at key and either rejects the request or passes it on.
+func takeToken(c echo.Context, store ratelimit.Store, key string, limit ratelimit.Limit, next echo.HandlerFunc) error {
+	result, err := store.Take(c.Request().Context(), key, limit, time.Now())
+	if err != nil {
+		slog.ErrorContext(c.Request().Context(), "rate limit store failed", "error", err)
+		return next(c)
+	}
+
+	header := c.Response().Header()
+	header.Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+	header.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	header.Set("RateLimit-Reset", ceilSeconds(result.Reset))
+
+	if !result.Allowed {
+		header.Set(echo.HeaderRetryAfter, ceilSeconds(result.RetryAfter))
+		return customErrors.HandleHTTPError(c, customErrors.NewError(customErrors.RateLimitedError, "rate limit exceeded"))
+	}
+
+	return next(c)
+}
+
+// clientID identifies the authenticated client making a request.
+func clientID(c echo.Context) (string, bool) {
+	p, ok := auth.PrincipalFromContext(c.Request().Context())
+	if !ok {
+		return "", false
+	}
+	if p.APIKeyID != "" {
+		return "key:" + p.APIKeyID, true
+	}
+	return "user:" + p.Subject, true
+}
+
+// ceilSeconds formats a duration as whole seconds, rounded up.
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,148 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"player_management_system/internal/pkg/auth"
+	"player_management_system/internal/pkg/ratelimit"
+)
+
+// failingStore is a rate limit store that is always down.
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, ratelimit.Limit, time.Time) (ratelimit.Result, error) {
+	return ratelimit.Result{}, errors.New("store is down")
+}
+
+func newRateLimitTestServer(store ratelimit.Store) *echo.Echo {
+	e := echo.New()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if key := c.Request().Header.Get(HeaderAPIKey); key != "" {
+				ctx := auth.WithPrincipal(c.Request().Context(), &auth.Principal{Subject: "acme", APIKeyID: key})
+				c.SetRequest(c.Request().WithContext(ctx))
+			}
+			return next(c)
+		}
+	})
+	e.Use(RateLimit(store, RateLimitConfig{
+		Read:  ratelimit.Limit{Rate: 1, Burst: 2},
+		Write: ratelimit.Limit{Rate: 1, Burst: 1},
+	}))
+	e.GET("/players", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	e.POST("/players", func(c echo.Context) error { return c.NoContent(http.StatusCreated) })
+	return e
+}
+
+func doRateLimited(e *echo.Echo, method, apiKey string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/players", nil)
+	if apiKey != "" {
+		req.Header.Set(HeaderAPIKey, apiKey)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRateLimit(t *testing.T) {
+	e := newRateLimitTestServer(ratelimit.NewMemoryStore())
+
+	rec := doRateLimited(e, http.MethodGet, "scraper")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "1", rec.Header().Get("RateLimit-Remaining"))
+
+	doRateLimited(e, http.MethodGet, "scraper")
+	rec = doRateLimited(e, http.MethodGet, "scraper")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get(echo.HeaderRetryAfter))
+	assert.JSONEq(t, `{"type":"about:blank","title":"Too Many Requests","status":429,"detail":"rate limit exceeded","instance":"/players","code":"RateLimited"}`, rec.Body.String())
+
+	// 쓰기는 별도의 버킷을 사용
+	rec = doRateLimited(e, http.MethodPost, "scraper")
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	// 다른 클라이언트는 영향을 받지 않음
+	rec = doRateLimited(e, http.MethodGet, "partner")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// 인증되지 않은 요청은 IP 제한에 맡김
+	for i := 0; i < 3; i++ {
+		rec = doRateLimited(e, http.MethodGet, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("RateLimit-Limit"))
+	}
+}
+
+func TestIPRateLimit(t *testing.T) {
+	store := ratelimit.NewMemoryStore()
+	e := echo.New()
+	e.Use(IPRateLimit(store, ratelimit.Limit{Rate: 1, Burst: 2}))
+	// 인증 실패도 IP 버킷에서 토큰을 소비함
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			return c.NoContent(http.StatusUnauthorized)
+		}
+	})
+	e.GET("/players", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	e.GET(healthzPath, func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	do := func(path, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(HeaderAPIKey, "guess")
+		req.RemoteAddr = ip + ":1234"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, do("/players", "192.0.2.1").Code)
+	assert.Equal(t, http.StatusUnauthorized, do("/players", "192.0.2.1").Code)
+	rec := do("/players", "192.0.2.1")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get(echo.HeaderRetryAfter))
+
+	// 다른 IP와 상태 확인은 영향을 받지 않음
+	assert.Equal(t, http.StatusUnauthorized, do("/players", "192.0.2.2").Code)
+	assert.Equal(t, http.StatusUnauthorized, do(healthzPath, "192.0.2.1").Code)
+}
+
+func TestIPExtractor(t *testing.T) {
+	request := func(remoteAddr, forwardedFor string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/players", nil)
+		req.RemoteAddr = remoteAddr + ":1234"
+		req.Header.Set(echo.HeaderXForwardedFor, forwardedFor)
+		req.Header.Set(echo.HeaderXRealIP, forwardedFor)
+		return req
+	}
+
+	// 프록시가 없으면 클라이언트가 보낸 헤더는 무시함
+	direct, err := IPExtractor(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "192.0.2.1", direct(request("192.0.2.1", "198.51.100.7")))
+
+	proxied, err := IPExtractor([]string{"10.0.0.0/8"})
+	assert.NoError(t, err)
+	assert.Equal(t, "198.51.100.7", proxied(request("10.0.0.2", "203.0.113.9, 198.51.100.7")))
+	// 신뢰하지 않는 주소에서 온 요청은 헤더를 믿지 않음
+	assert.Equal(t, "192.0.2.1", proxied(request("192.0.2.1", "198.51.100.7")))
+	assert.Equal(t, "172.16.0.3", proxied(request("172.16.0.3", "198.51.100.7")))
+
+	_, err = IPExtractor([]string{"10.0.0.0"})
+	assert.Error(t, err)
+}
+
+func TestRateLimit_StoreFailure(t *testing.T) {
+	e := newRateLimitTestServer(failingStore{})
+
+	rec := doRateLimited(e, http.MethodGet, "scraper")
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
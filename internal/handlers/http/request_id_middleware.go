@@ -0,0 +1,44 @@
+package http
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"player_management_system/internal/pkg/logging"
+)
+
+// maxRequestIDLength bounds the request IDs taken from clients.
+const maxRequestIDLength = 128
+
+// RequestID returns a middleware that gives every request an ID, taken from the X-Request-ID header
+// when the client sent a valid one and generated otherwise. The ID is sent back in the X-Request-ID
+// response header and stored in the request context, where the logger picks it up.
+func RequestID() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			id := c.Request().Header.Get(echo.HeaderXRequestID)
+			if !validRequestID(id) {
+				id = uuid.NewString()
+			}
+
+			c.Response().Header().Set(echo.HeaderXRequestID, id)
+			ctx := logging.WithRequestID(c.Request().Context(), id)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
+
+// validRequestID reports whether a request ID sent by a client is safe to log and echo back: short
+// and made of visible ASCII characters only.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"player_management_system/internal/pkg/logging"
+)
+
+// newRequestIDTestServer answers with the request ID stored in the request context.
+func newRequestIDTestServer() *echo.Echo {
+	e := echo.New()
+	e.Use(RequestID())
+	e.GET("/players", func(c echo.Context) error {
+		id, _ := logging.RequestIDFromContext(c.Request().Context())
+		return c.String(http.StatusOK, id)
+	})
+	return e
+}
+
+func TestRequestID_KeepsValidID(t *testing.T) {
+	e := newRequestIDTestServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/players", nil)
+	req.Header.Set(echo.HeaderXRequestID, "client-req-1")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, "client-req-1", rec.Header().Get(echo.HeaderXRequestID))
+	assert.Equal(t, "client-req-1", rec.Body.String())
+}
+
+func TestRequestID_ReplacesInvalidID(t *testing.T) {
+	e := newRequestIDTestServer()
+
+	for _, id := range []string{"", "has space", "line\nbreak", strings.Repeat("a", maxRequestIDLength+1)} {
+		req := httptest.NewRequest(http.MethodGet, "/players", nil)
+		req.Header.Set(echo.HeaderXRequestID, id)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		// 잘못된 ID는 새로 만든 ID로 바꿈
+		got := rec.Header().Get(echo.HeaderXRequestID)
+		_, err := uuid.Parse(got)
+		assert.NoError(t, err, "request ID %q", id)
+		assert.Equal(t, got, rec.Body.String())
+	}
+}
@@ -0,0 +1,47 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequestLogger returns a middleware that logs every request once it is handled, with its route,
+// status and latency. The record carries the request ID and trace ID like those logged while
+// handling the request, so that a failed request and its errors can be found together. Like Metrics
+// it hands errors to the error handler first, and it must run after RequestID and Tracing. Server
+// errors are logged as errors, health probes and metrics scrapes at debug level.
+func RequestLogger(logger *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			req := c.Request()
+			res := c.Response()
+			level := slog.LevelInfo
+			switch {
+			case res.Status >= http.StatusInternalServerError:
+				level = slog.LevelError
+			case isProbe(c):
+				level = slog.LevelDebug
+			}
+
+			logger.LogAttrs(req.Context(), level, "request handled",
+				slog.String("method", req.Method),
+				slog.String("route", c.Path()),
+				slog.String("uri", req.RequestURI),
+				slog.Int("status", res.Status),
+				slog.Duration("latency", time.Since(start)),
+				slog.Int64("bytes_out", res.Size),
+				slog.String("remote_ip", c.RealIP()),
+				slog.String("user_agent", req.UserAgent()),
+			)
+			return nil
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	customErrors "player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/logging"
+)
+
+// newRequestLoggerTestServer writes the access log as JSON lines to buf.
+func newRequestLoggerTestServer(t *testing.T, buf *bytes.Buffer) *echo.Echo {
+	logger, err := logging.New(logging.Config{Level: "debug", Format: logging.FormatJSON, SampleRatio: 1}, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := echo.New()
+	e.HTTPErrorHandler = customErrors.HTTPErrorHandler
+	e.Use(RequestID())
+	e.Use(Tracing(sdktrace.NewTracerProvider()))
+	e.Use(RequestLogger(logger))
+	e.GET("/players/:id", func(c echo.Context) error {
+		if c.Param("id") == "broken" {
+			return customErrors.NewHTTPError(customErrors.NewError(customErrors.DatabaseError, "connection reset"))
+		}
+		return c.String(http.StatusOK, "ok")
+	})
+	return e
+}
+
+// lastRecord parses the last JSON line written to buf.
+func lastRecord(t *testing.T, buf *bytes.Buffer) map[string]interface{} {
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	var record map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &record); err != nil {
+		t.Fatalf("invalid log line %q: %v", lines[len(lines)-1], err)
+	}
+	return record
+}
+
+func TestRequestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	e := newRequestLoggerTestServer(t, &buf)
+
+	req := httptest.NewRequest(http.MethodGet, "/players/1", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-1")
+	req.Header.Set("traceparent", testTraceparent)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	record := lastRecord(t, &buf)
+	assert.Equal(t, "request handled", record["msg"])
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, "/players/:id", record["route"])
+	assert.Equal(t, float64(http.StatusOK), record["status"])
+	// 요청 ID와 추적 ID로 같은 요청의 기록을 묶음
+	assert.Equal(t, "req-1", record["request_id"])
+	assert.Equal(t, testTraceID, record["trace_id"])
+}
+
+func TestRequestLogger_ServerError(t *testing.T) {
+	var buf bytes.Buffer
+	e := newRequestLoggerTestServer(t, &buf)
+
+	req := httptest.NewRequest(http.MethodGet, "/players/broken", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-2")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	record := lastRecord(t, &buf)
+	assert.Equal(t, "ERROR", record["level"])
+	assert.Equal(t, float64(http.StatusInternalServerError), record["status"])
+	assert.Equal(t, "req-2", record["request_id"])
+}
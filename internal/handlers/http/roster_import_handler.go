@@ -0,0 +1,102 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	customErrors "player_management_system/internal/pkg/errors"
+	"player_management_system/internal/services/roster"
+)
+
+// maxRosterFileSize is the largest roster file accepted for upload.
+const maxRosterFileSize = 10 << 20
+
+// RosterImportHandler handles HTTP requests for roster imports.
+type RosterImportHandler struct {
+	importer *roster.Importer
+}
+
+// NewRosterImportHandler creates a new RosterImportHandler.
+func NewRosterImportHandler(importer *roster.Importer) *RosterImportHandler {
+	return &RosterImportHandler{importer: importer}
+}
+
+// RegisterRoutes registers the roster import routes with the Echo router.
+func (h *RosterImportHandler) RegisterRoutes(e *echo.Echo) {
+	e.POST("/players/import", h.ImportRoster)
+}
+
+// ImportRoster handles the POST /players/import multipart request.
+// Form fields: file (CSV or XLSX), format (defaults to the file extension), mapping (JSON object of
+// field to column header), dry_run (defaults to true) and upsert.
+// The validation report is returned with 200, or with 422 when any row was rejected. Row errors are
+// in the language of the request.
+func (h *RosterImportHandler) ImportRoster(c echo.Context) error {
+	// 권한이 없는 요청은 파일을 받기 전에 거부
+	if err := h.importer.Authorize(c.Request().Context()); err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	// 멀티파트 파싱 전에 본문 크기를 제한
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, maxRosterFileSize+multipartOverhead)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return customErrors.NewHTTPError(customErrors.NewErrorWithKey(customErrors.PayloadTooLargeError, customErrors.MsgFileTooLarge, maxRosterFileSize>>20))
+		}
+		return customErrors.NewHTTPError(customErrors.NewFieldError("file", customErrors.MsgRequired))
+	}
+	if fileHeader.Size > maxRosterFileSize {
+		return customErrors.NewHTTPError(customErrors.NewErrorWithKey(customErrors.PayloadTooLargeError, customErrors.MsgFileTooLarge, maxRosterFileSize>>20))
+	}
+
+	opts := roster.Options{DryRun: true, Language: customErrors.RequestLanguage(c)}
+
+	if format := c.FormValue("format"); format != "" {
+		opts.Format, err = roster.ParseFormat(format)
+	} else {
+		opts.Format, err = roster.FormatFromFilename(fileHeader.Filename)
+	}
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	if mapping := c.FormValue("mapping"); mapping != "" {
+		if err := json.Unmarshal([]byte(mapping), &opts.Mapping); err != nil {
+			return customErrors.NewHTTPError(customErrors.NewFieldError("mapping", customErrors.MsgInvalidValue))
+		}
+	}
+
+	if dryRun := c.FormValue("dry_run"); dryRun != "" {
+		if opts.DryRun, err = strconv.ParseBool(dryRun); err != nil {
+			return customErrors.NewHTTPError(customErrors.NewFieldError("dry_run", customErrors.MsgInvalidValue))
+		}
+	}
+
+	if upsert := c.FormValue("upsert"); upsert != "" {
+		if opts.Upsert, err = strconv.ParseBool(upsert); err != nil {
+			return customErrors.NewHTTPError(customErrors.NewFieldError("upsert", customErrors.MsgInvalidValue))
+		}
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return customErrors.NewHTTPError(customErrors.NewFieldError("file", customErrors.MsgInvalidValue))
+	}
+	defer file.Close()
+
+	report, err := h.importer.Import(c.Request().Context(), file, opts)
+	if err != nil {
+		return customErrors.NewHTTPError(err)
+	}
+
+	if len(report.Errors) > 0 {
+		return c.JSON(http.StatusUnprocessableEntity, report)
+	}
+	return c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName names the tracer of the HTTP layer.
+const tracerName = "player_management_system/internal/handlers/http"
+
+// Tracing returns a middleware that records every request as a server span named after the method
+// and the route template, continuing the trace of an incoming W3C traceparent header. Like Metrics
+// it hands errors to the error handler, so that the span gets the status they are rendered with,
+// and it must be registered before the middlewares that log with the trace ID. Health probes and
+// metrics scrapes are not traced.
+func Tracing(tp trace.TracerProvider) echo.MiddlewareFunc {
+	tracer := tp.Tracer(tracerName)
+	propagator := propagation.TraceContext{}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if isProbe(c) {
+				return next(c)
+			}
+
+			req := c.Request()
+			route := c.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+
+			ctx := propagator.Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+			ctx, span := tracer.Start(ctx, req.Method+" "+route,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(req.Method),
+					semconv.HTTPRoute(route),
+					semconv.URLPath(req.URL.Path),
+				),
+			)
+			defer span.End()
+			c.SetRequest(req.WithContext(ctx))
+
+			if err := next(c); err != nil {
+				span.RecordError(err)
+				c.Error(err)
+			}
+
+			status := c.Response().Status
+			span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+			return nil
+		}
+	}
+}
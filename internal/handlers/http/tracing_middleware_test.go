@@ -0,0 +1,103 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+const (
+	testTraceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testTraceparent = "00-" + testTraceID + "-00f067aa0ba902b7-01"
+)
+
+// newTracingTestServer records the spans of the requests in memory.
+func newTracingTestServer() (*echo.Echo, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	e := echo.New()
+	e.HTTPErrorHandler = customErrors.HTTPErrorHandler
+	e.Use(Tracing(tp))
+	e.GET("/players/:id", func(c echo.Context) error {
+		switch c.Param("id") {
+		case "missing":
+			return customErrors.NewHTTPError(customErrors.NewError(customErrors.NotFoundError, "player not found"))
+		case "broken":
+			return customErrors.NewHTTPError(customErrors.NewError(customErrors.DatabaseError, "connection reset"))
+		}
+		return c.String(http.StatusOK, trace.SpanContextFromContext(c.Request().Context()).TraceID().String())
+	})
+	return e, exporter
+}
+
+func TestTracing_ContinuesIncomingTrace(t *testing.T) {
+	e, exporter := newTracingTestServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/players/1", nil)
+	req.Header.Set("traceparent", testTraceparent)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	// 요청 처리 중의 추적 ID는 호출한 쪽의 추적을 이어받음
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, testTraceID, rec.Body.String())
+
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 1) {
+		span := spans[0]
+		assert.Equal(t, "GET /players/:id", span.Name)
+		assert.Equal(t, testTraceID, span.SpanContext.TraceID().String())
+		assert.Equal(t, "00f067aa0ba902b7", span.Parent.SpanID().String())
+		assert.True(t, span.Parent.IsRemote())
+		assert.Contains(t, span.Attributes, attribute.String("http.route", "/players/:id"))
+		assert.Contains(t, span.Attributes, attribute.Int("http.response.status_code", http.StatusOK))
+	}
+}
+
+func TestTracing_Errors(t *testing.T) {
+	e, exporter := newTracingTestServer()
+
+	for _, path := range []string{"/players/missing", "/players/broken"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("traceparent", testTraceparent)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		// 오류 응답에는 추적 ID가 들어감
+		assert.Contains(t, rec.Body.String(), `"trace_id":"`+testTraceID+`"`)
+	}
+
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 2) {
+		// 클라이언트 오류는 실패로 표시하지 않음
+		assert.Contains(t, spans[0].Attributes, attribute.Int("http.response.status_code", http.StatusNotFound))
+		assert.Equal(t, codes.Unset, spans[0].Status.Code)
+		assert.Contains(t, spans[1].Attributes, attribute.Int("http.response.status_code", http.StatusInternalServerError))
+		assert.Equal(t, codes.Error, spans[1].Status.Code)
+	}
+}
+
+func TestTracing_NewTrace(t *testing.T) {
+	e, exporter := newTracingTestServer()
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/unknown", nil))
+
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, "GET unmatched", spans[0].Name)
+		assert.False(t, spans[0].Parent.IsValid())
+		assert.Contains(t, rec.Body.String(), `"trace_id":"`+spans[0].SpanContext.TraceID().String()+`"`)
+	}
+}
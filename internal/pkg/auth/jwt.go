@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"player_management_system/internal/pkg/errors"
+)
+
+// JWTConfig represents the keys and claims used to validate bearer tokens.
+// At least one of HMACSecret, RSAPublicKeyFile or JWKSFile must be set.
+type JWTConfig struct {
+	// HMACSecret validates HS256 tokens.
+	HMACSecret string
+	// RSAPublicKeyFile is a PEM encoded public key validating RS256 tokens without a kid.
+	RSAPublicKeyFile string
+	// JWKSFile is a local JSON Web Key Set validating RS256 tokens by kid.
+	JWKSFile string
+	Issuer   string
+	Audience string
+}
+
+// Claims are the JWT claims understood by the API.
+type Claims struct {
+	Name  string   `json:"name,omitempty"`
+	Roles []string `json:"roles,omitempty"`
+	Team  string   `json:"team,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// JWTVerifier validates bearer tokens and turns them into principals.
+type JWTVerifier struct {
+	hmacSecret []byte
+	rsaKeys    map[string]*rsa.PublicKey
+	parser     *jwt.Parser
+}
+
+// NewJWTVerifier creates a JWTVerifier from the configured keys.
+func NewJWTVerifier(cfg JWTConfig) (*JWTVerifier, error) {
+	v := &JWTVerifier{rsaKeys: map[string]*rsa.PublicKey{}}
+
+	if cfg.HMACSecret != "" {
+		v.hmacSecret = []byte(cfg.HMACSecret)
+	}
+
+	if cfg.RSAPublicKeyFile != "" {
+		data, err := os.ReadFile(cfg.RSAPublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read RSA public key: %w", err)
+		}
+		key, err := jwt.ParseRSAPublicKeyFromPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RSA public key: %w", err)
+		}
+		v.rsaKeys[""] = key
+	}
+
+	if cfg.JWKSFile != "" {
+		keys, err := LoadJWKSFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		for kid, key := range keys {
+			v.rsaKeys[kid] = key
+		}
+	}
+
+	if v.hmacSecret == nil && len(v.rsaKeys) == 0 {
+		return nil, fmt.Errorf("no JWT verification key configured")
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodRS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	v.parser = jwt.NewParser(opts...)
+
+	return v, nil
+}
+
+// Verify validates a token and returns the principal it identifies.
+func (v *JWTVerifier) Verify(tokenString string) (*Principal, error) {
+	var claims Claims
+	_, err := v.parser.ParseWithClaims(tokenString, &claims, v.key)
+	if err != nil {
+		return nil, errors.NewErrorWithArgs(errors.UnauthenticatedError, "invalid token: %v", err)
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.NewError(errors.UnauthenticatedError, "invalid token: missing subject")
+	}
+
+	return &Principal{
+		Subject: claims.Subject,
+		Name:    claims.Name,
+		Roles:   claims.Roles,
+		Team:    claims.Team,
+	}, nil
+}
+
+// key selects the verification key for a token by its algorithm and kid.
+func (v *JWTVerifier) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		if v.hmacSecret == nil {
+			return nil, fmt.Errorf("HS256 tokens are not accepted")
+		}
+		return v.hmacSecret, nil
+	case jwt.SigningMethodRS256.Alg():
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := v.rsaKeys[kid]; ok {
+			return key, nil
+		}
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	default:
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+}
@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+const testSecret = "test-secret"
+
+func mintHS256(t *testing.T, claims Claims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testSecret))
+	assert.NoError(t, err)
+	return token
+}
+
+func mintRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims Claims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	assert.NoError(t, err)
+	return signed
+}
+
+func validClaims() Claims {
+	return Claims{
+		Name:  "Manager",
+		Roles: []string{"team_manager"},
+		Team:  "기아",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-1",
+			Issuer:    "test-issuer",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+func writeJWKS(t *testing.T, kid string, key *rsa.PublicKey) string {
+	jwks := fmt.Sprintf(`{"keys":[{"kty":"RSA","kid":%q,"use":"sig","alg":"RS256","n":%q,"e":%q}]}`,
+		kid,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	assert.NoError(t, os.WriteFile(path, []byte(jwks), 0o600))
+	return path
+}
+
+func TestJWTVerifier_HS256(t *testing.T) {
+	verifier, err := NewJWTVerifier(JWTConfig{HMACSecret: testSecret, Issuer: "test-issuer"})
+	assert.NoError(t, err)
+
+	principal, err := verifier.Verify(mintHS256(t, validClaims()))
+	assert.NoError(t, err)
+	assert.Equal(t, &Principal{Subject: "user-1", Name: "Manager", Roles: []string{"team_manager"}, Team: "기아"}, principal)
+}
+
+func TestJWTVerifier_RS256WithJWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	verifier, err := NewJWTVerifier(JWTConfig{JWKSFile: writeJWKS(t, "key-1", &key.PublicKey)})
+	assert.NoError(t, err)
+
+	principal, err := verifier.Verify(mintRS256(t, key, "key-1", validClaims()))
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", principal.Subject)
+
+	_, err = verifier.Verify(mintRS256(t, key, "key-2", validClaims()))
+	assert.Error(t, err)
+}
+
+func TestJWTVerifier_RS256WithPEM(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "key.pem")
+	assert.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	verifier, err := NewJWTVerifier(JWTConfig{RSAPublicKeyFile: path})
+	assert.NoError(t, err)
+
+	_, err = verifier.Verify(mintRS256(t, key, "", validClaims()))
+	assert.NoError(t, err)
+}
+
+func TestJWTVerifier_Rejects(t *testing.T) {
+	verifier, err := NewJWTVerifier(JWTConfig{HMACSecret: testSecret, Issuer: "test-issuer"})
+	assert.NoError(t, err)
+
+	expired := validClaims()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+
+	wrongIssuer := validClaims()
+	wrongIssuer.Issuer = "someone-else"
+
+	noExpiry := validClaims()
+	noExpiry.ExpiresAt = nil
+
+	noSubject := validClaims()
+	noSubject.Subject = ""
+
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	assert.NoError(t, err)
+
+	wrongSecret, err := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims()).SignedString([]byte("other-secret"))
+	assert.NoError(t, err)
+
+	tokens := map[string]string{
+		"expired":      mintHS256(t, expired),
+		"wrong issuer": mintHS256(t, wrongIssuer),
+		"no expiry":    mintHS256(t, noExpiry),
+		"no subject":   mintHS256(t, noSubject),
+		"alg none":     unsigned,
+		"wrong secret": wrongSecret,
+		"garbage":      "not-a-token",
+	}
+	for name, token := range tokens {
+		t.Run(name, func(t *testing.T) {
+			_, err := verifier.Verify(token)
+
+			var customErr *customErrors.Error
+			if assert.ErrorAs(t, err, &customErr) {
+				assert.Equal(t, customErrors.UnauthenticatedError, customErr.Code)
+			}
+		})
+	}
+}
+
+func TestNewJWTVerifier_NoKeys(t *testing.T) {
+	_, err := NewJWTVerifier(JWTConfig{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"player_management_system/internal/pkg/errors"
+)
+
+// Roles a principal can hold.
+const (
+	RoleViewer      = "viewer"
+	RoleEditor      = "editor"
+	RoleTeamManager = "team_manager"
+	RoleAdmin       = "admin"
+)
+
+// Scopes an API key can grant.
+const (
+	ScopePlayersRead  = "players:read"
+	ScopePlayersWrite = "players:write"
+	ScopeMediaWrite   = "media:write"
+)
+
+// Action is an operation on players subject to authorization.
+type Action string
+
+// Player actions.
+const (
+	ActionRead   Action = "read"
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+	ActionPurge  Action = "purge"
+	// ActionUploadMedia uploads media, such as a profile image, for a player.
+	ActionUploadMedia Action = "upload media for"
+)
+
+// HasRole reports whether the principal holds the role.
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// Can reports whether the principal may perform the action on a player of the given team.
+//
+//   - admins may do anything;
+//   - viewers, editors and team managers may read;
+//   - editors may create and update any player and upload media for them;
+//   - team managers may do the same for players of their own team only;
+//   - only admins may delete or purge.
+//
+// API key principals may read with players:read or players:write, create and update any player
+// with players:write, upload media with media:write or players:write, and never delete or purge.
+//
+// An empty team asks whether the action is allowed regardless of the team.
+func (p *Principal) Can(action Action, team string) bool {
+	if p.APIKeyID != "" {
+		return p.scopeAllows(action)
+	}
+
+	if p.HasRole(RoleAdmin) {
+		return true
+	}
+
+	switch action {
+	case ActionRead:
+		return p.HasRole(RoleViewer) || p.HasRole(RoleEditor) || p.HasRole(RoleTeamManager)
+	case ActionCreate, ActionUpdate, ActionUploadMedia:
+		if p.HasRole(RoleEditor) {
+			return true
+		}
+		return p.HasRole(RoleTeamManager) && p.Team != "" && team == p.Team
+	default:
+		return false
+	}
+}
+
+// HasScope reports whether the principal was granted the scope.
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+func (p *Principal) scopeAllows(action Action) bool {
+	switch action {
+	case ActionRead:
+		return p.HasScope(ScopePlayersRead) || p.HasScope(ScopePlayersWrite)
+	case ActionCreate, ActionUpdate:
+		return p.HasScope(ScopePlayersWrite)
+	case ActionUploadMedia:
+		return p.HasScope(ScopeMediaWrite) || p.HasScope(ScopePlayersWrite)
+	default:
+		return false
+	}
+}
+
+// RequireAdmin checks that the principal in ctx is an admin authenticated as a user.
+// API keys never grant administrative access.
+func RequireAdmin(ctx context.Context) error {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return errors.NewError(errors.UnauthenticatedError, "authentication required")
+	}
+	if p.APIKeyID != "" || !p.HasRole(RoleAdmin) {
+		return errors.NewError(errors.ForbiddenError, "admin role required")
+	}
+	return nil
+}
+
+// Authorize checks that the principal in ctx may perform the action on a player of the given team.
+// It returns an Unauthenticated error when ctx carries no principal and a Forbidden error when
+// the principal lacks permission.
+func Authorize(ctx context.Context, action Action, team string) error {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return errors.NewError(errors.UnauthenticatedError, "authentication required")
+	}
+
+	if !p.Can(action, team) {
+		if team != "" {
+			return errors.NewErrorWithArgs(errors.ForbiddenError, "not allowed to %s players of team %s", action, team)
+		}
+		return errors.NewErrorWithArgs(errors.ForbiddenError, "not allowed to %s players", action)
+	}
+
+	return nil
+}
+
+// AllowedTeams returns the teams of the players the principal in ctx may perform the action on, or
+// nil when the action is allowed regardless of the team; a principal allowed on no team gets an empty
+// slice. It is meant for checks a repository makes on the rows it locks, which avoids authorizing
+// rows that may change before they are written.
+func AllowedTeams(ctx context.Context, action Action) []string {
+	p, ok := PrincipalFromContext(ctx)
+	switch {
+	case !ok:
+		return []string{}
+	case p.Can(action, ""):
+		return nil
+	case p.Team != "" && p.Can(action, p.Team):
+		return []string{p.Team}
+	default:
+		return []string{}
+	}
+}
+
+// AuthorizeAnyTeam checks that the principal in ctx may perform the action on players of at least
+// one team. It is meant for checks made before the teams involved are known, such as before reading
+// an uploaded file; the players must still be authorized one by one. Errors are those of Authorize.
+func AuthorizeAnyTeam(ctx context.Context, action Action) error {
+	if p, ok := PrincipalFromContext(ctx); ok && p.Team != "" && p.Can(action, p.Team) {
+		return nil
+	}
+	return Authorize(ctx, action, "")
+}
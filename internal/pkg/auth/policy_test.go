@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+func TestPrincipal_Can(t *testing.T) {
+	viewer := &Principal{Subject: "v", Roles: []string{RoleViewer}}
+	editor := &Principal{Subject: "e", Roles: []string{RoleEditor}}
+	manager := &Principal{Subject: "m", Roles: []string{RoleTeamManager}, Team: "기아"}
+	teamless := &Principal{Subject: "t", Roles: []string{RoleTeamManager}}
+	admin := &Principal{Subject: "a", Roles: []string{RoleAdmin}}
+	nobody := &Principal{Subject: "n"}
+	reader := &Principal{Subject: "partner", APIKeyID: "k1", Scopes: []string{ScopePlayersRead}}
+	writer := &Principal{Subject: "partner", APIKeyID: "k2", Scopes: []string{ScopePlayersWrite}}
+	adminKey := &Principal{Subject: "partner", APIKeyID: "k3", Roles: []string{RoleAdmin}, Scopes: []string{ScopeMediaWrite}}
+	mediaKey := &Principal{Subject: "photographer", APIKeyID: "k4", Scopes: []string{ScopeMediaWrite}}
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		action    Action
+		team      string
+		allowed   bool
+	}{
+		{"viewer reads", viewer, ActionRead, "", true},
+		{"viewer cannot create", viewer, ActionCreate, "기아", false},
+		{"editor updates any team", editor, ActionUpdate, "LG", true},
+		{"editor cannot delete", editor, ActionDelete, "", false},
+		{"manager updates own team", manager, ActionUpdate, "기아", true},
+		{"manager cannot update other team", manager, ActionUpdate, "LG", false},
+		{"manager cannot update regardless of team", manager, ActionUpdate, "", false},
+		{"manager without team cannot create", teamless, ActionCreate, "", false},
+		{"manager cannot purge", manager, ActionPurge, "기아", false},
+		{"admin deletes", admin, ActionDelete, "", true},
+		{"admin purges", admin, ActionPurge, "", true},
+		{"no role cannot read", nobody, ActionRead, "", false},
+		{"read key reads", reader, ActionRead, "", true},
+		{"read key cannot create", reader, ActionCreate, "LG", false},
+		{"write key reads", writer, ActionRead, "", true},
+		{"write key updates any team", writer, ActionUpdate, "LG", true},
+		{"write key cannot delete", writer, ActionDelete, "", false},
+		{"key ignores roles", adminKey, ActionRead, "", false},
+		{"editor uploads media", editor, ActionUploadMedia, "LG", true},
+		{"manager uploads media for own team", manager, ActionUploadMedia, "기아", true},
+		{"manager cannot upload media for other team", manager, ActionUploadMedia, "LG", false},
+		{"viewer cannot upload media", viewer, ActionUploadMedia, "기아", false},
+		{"media key uploads media", mediaKey, ActionUploadMedia, "LG", true},
+		{"media key cannot update", mediaKey, ActionUpdate, "LG", false},
+		{"write key uploads media", writer, ActionUploadMedia, "LG", true},
+		{"read key cannot upload media", reader, ActionUploadMedia, "LG", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.allowed, tt.principal.Can(tt.action, tt.team))
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	var customErr *customErrors.Error
+
+	err := Authorize(context.Background(), ActionRead, "")
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.UnauthenticatedError, customErr.Code)
+	}
+
+	ctx := WithPrincipal(context.Background(), &Principal{Subject: "v", Roles: []string{RoleViewer}})
+	assert.NoError(t, Authorize(ctx, ActionRead, ""))
+
+	err = Authorize(ctx, ActionCreate, "기아")
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.ForbiddenError, customErr.Code)
+		assert.Equal(t, "not allowed to create players of team 기아", customErr.Message)
+	}
+}
+
+func TestAuthorizeAnyTeam(t *testing.T) {
+	var customErr *customErrors.Error
+
+	err := AuthorizeAnyTeam(context.Background(), ActionCreate)
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.UnauthenticatedError, customErr.Code)
+	}
+
+	// 팀 관리자는 자신의 팀 선수만 만들 수 있어도 허용됨
+	manager := WithPrincipal(context.Background(), &Principal{Subject: "m", Roles: []string{RoleTeamManager}, Team: "기아"})
+	assert.NoError(t, AuthorizeAnyTeam(manager, ActionCreate))
+	editor := WithPrincipal(context.Background(), &Principal{Subject: "e", Roles: []string{RoleEditor}})
+	assert.NoError(t, AuthorizeAnyTeam(editor, ActionCreate))
+
+	viewer := WithPrincipal(context.Background(), &Principal{Subject: "v", Roles: []string{RoleViewer}})
+	err = AuthorizeAnyTeam(viewer, ActionCreate)
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.ForbiddenError, customErr.Code)
+		assert.Equal(t, "not allowed to create players", customErr.Message)
+	}
+}
+
+func TestAllowedTeams(t *testing.T) {
+	assert.Equal(t, []string{}, AllowedTeams(context.Background(), ActionUpdate))
+
+	editor := WithPrincipal(context.Background(), &Principal{Subject: "e", Roles: []string{RoleEditor}})
+	assert.Nil(t, AllowedTeams(editor, ActionUpdate))
+
+	manager := WithPrincipal(context.Background(), &Principal{Subject: "m", Roles: []string{RoleTeamManager}, Team: "기아"})
+	assert.Equal(t, []string{"기아"}, AllowedTeams(manager, ActionUpdate))
+	assert.Equal(t, []string{}, AllowedTeams(manager, ActionDelete))
+}
+
+func TestRequireAdmin(t *testing.T) {
+	var customErr *customErrors.Error
+
+	err := RequireAdmin(context.Background())
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.UnauthenticatedError, customErr.Code)
+	}
+
+	ctx := WithPrincipal(context.Background(), &Principal{Subject: "a", Roles: []string{RoleAdmin}})
+	assert.NoError(t, RequireAdmin(ctx))
+
+	ctx = WithPrincipal(context.Background(), &Principal{Subject: "a", APIKeyID: "k", Roles: []string{RoleAdmin}})
+	err = RequireAdmin(ctx)
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.ForbiddenError, customErr.Code)
+	}
+}
@@ -0,0 +1,28 @@
+package auth
+
+import "context"
+
+// Principal is the authenticated identity behind a request.
+type Principal struct {
+	Subject string   `json:"sub"`
+	Name    string   `json:"name,omitempty"`
+	Roles   []string `json:"roles,omitempty"`
+	Team    string   `json:"team,omitempty"`
+	// APIKeyID and Scopes are set when the principal authenticated with an API key.
+	// Such principals are authorized by their scopes instead of their roles.
+	APIKeyID string   `json:"api_key_id,omitempty"`
+	Scopes   []string `json:"scopes,omitempty"`
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the principal.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal stored in ctx, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
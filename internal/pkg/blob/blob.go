@@ -0,0 +1,30 @@
+// Package blob stores uploaded files such as profile images.
+package blob
+
+import (
+	"context"
+	"regexp"
+
+	"player_management_system/internal/pkg/errors"
+)
+
+// Store keeps blobs by key and serves them from public URLs.
+// Keys are slash separated paths such as players/<id>/<hash>/256.jpg.
+type Store interface {
+	// Put stores data under key, replacing any previous blob, and returns its public URL.
+	Put(ctx context.Context, key string, data []byte, contentType string) (string, error)
+	// Delete removes the blob stored under key. Deleting a missing blob is not an error.
+	Delete(ctx context.Context, key string) error
+	// BaseURL returns the URL that the public URLs of all blobs start with, followed by a slash and the key.
+	BaseURL() string
+}
+
+var keyPattern = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9_.-]*(/[A-Za-z0-9_-][A-Za-z0-9_.-]*)*$`)
+
+// validateKey rejects keys that could escape the store, such as absolute paths or "..".
+func validateKey(key string) error {
+	if len(key) > 1024 || !keyPattern.MatchString(key) {
+		return errors.NewErrorWithArgs(errors.InvalidArgumentError, "Invalid blob key: %s", key)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package blob
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"player_management_system/internal/pkg/errors"
+)
+
+// FileSystemStore stores blobs as files below a root directory, which is expected to be
+// served by a static file server at the base URL.
+type FileSystemStore struct {
+	root    string
+	baseURL string
+}
+
+// NewFileSystemStore creates a store writing below root whose files are served at baseURL.
+func NewFileSystemStore(root, baseURL string) *FileSystemStore {
+	return &FileSystemStore{root: root, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// Put implements Store. The file is written to a temporary name first and renamed into place,
+// so readers never see a partial blob.
+func (s *FileSystemStore) Put(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(s.root, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", errors.Wrap(errors.InternalError, "filesystem.Put", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return "", errors.Wrap(errors.InternalError, "filesystem.Put", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", errors.Wrap(errors.InternalError, "filesystem.Put", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", errors.Wrap(errors.InternalError, "filesystem.Put", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return "", errors.Wrap(errors.InternalError, "filesystem.Put", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", errors.Wrap(errors.InternalError, "filesystem.Put", err)
+	}
+
+	return s.baseURL + "/" + key, nil
+}
+
+// BaseURL implements Store.
+func (s *FileSystemStore) BaseURL() string {
+	return s.baseURL
+}
+
+// Delete implements Store.
+func (s *FileSystemStore) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	err := os.Remove(filepath.Join(s.root, filepath.FromSlash(key)))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return errors.Wrap(errors.InternalError, "filesystem.Delete", err)
+	}
+	return nil
+}
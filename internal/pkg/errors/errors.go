@@ -4,8 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
+	"golang.org/x/text/language"
 )
 
 // ErrorCode represents a custom error code.
@@ -13,31 +15,93 @@ type ErrorCode string
 
 // Define custom error codes.
 const (
-	InvalidArgumentError ErrorCode = "InvalidArgument"
-	NotFoundError        ErrorCode = "NotFound"
-	InternalError        ErrorCode = "Internal"
-	DatabaseError        ErrorCode = "DatabaseError"
-	NotConnectedError    ErrorCode = "NotConnected"
+	InvalidArgumentError      ErrorCode = "InvalidArgument"
+	NotFoundError             ErrorCode = "NotFound"
+	AlreadyExistsError        ErrorCode = "AlreadyExists"
+	FailedPreconditionError   ErrorCode = "FailedPrecondition"
+	AbortedError              ErrorCode = "Aborted"
+	UnprocessableError        ErrorCode = "Unprocessable"
+	PayloadTooLargeError      ErrorCode = "PayloadTooLarge"
+	UnsupportedMediaTypeError ErrorCode = "UnsupportedMediaType"
+	UnauthenticatedError      ErrorCode = "Unauthenticated"
+	ForbiddenError            ErrorCode = "Forbidden"
+	RateLimitedError          ErrorCode = "RateLimited"
+	InternalError             ErrorCode = "Internal"
+	DatabaseError             ErrorCode = "DatabaseError"
+	NotConnectedError         ErrorCode = "NotConnected"
+	TimeoutError              ErrorCode = "Timeout"
 )
 
 // HTTP status codes for each error code
 var errorStatusCodes = map[ErrorCode]int{
-	InvalidArgumentError: http.StatusBadRequest,
-	NotFoundError:        http.StatusNotFound,
-	InternalError:        http.StatusInternalServerError,
-	DatabaseError:        http.StatusInternalServerError,
-	NotConnectedError:    http.StatusServiceUnavailable,
+	InvalidArgumentError:      http.StatusBadRequest,
+	NotFoundError:             http.StatusNotFound,
+	AlreadyExistsError:        http.StatusConflict,
+	FailedPreconditionError:   http.StatusConflict,
+	AbortedError:              http.StatusConflict,
+	UnprocessableError:        http.StatusUnprocessableEntity,
+	PayloadTooLargeError:      http.StatusRequestEntityTooLarge,
+	UnsupportedMediaTypeError: http.StatusUnsupportedMediaType,
+	UnauthenticatedError:      http.StatusUnauthorized,
+	ForbiddenError:            http.StatusForbidden,
+	RateLimitedError:          http.StatusTooManyRequests,
+	InternalError:             http.StatusInternalServerError,
+	DatabaseError:             http.StatusInternalServerError,
+	NotConnectedError:         http.StatusServiceUnavailable,
+	TimeoutError:              http.StatusGatewayTimeout,
+}
+
+// Error implements error, so that a code can be the target of errors.Is.
+func (c ErrorCode) Error() string {
+	return string(c)
 }
 
 // Error represents a custom error.
+// Only the code, message and fields are meant for clients; the operation, details, cause and stack
+// are for logs and are left out of JSON.
 type Error struct {
 	Code    ErrorCode `json:"code"`
 	Message string    `json:"message"`
+	// Fields lists the invalid request fields of an InvalidArgument error.
+	Fields []FieldError `json:"fields,omitempty"`
+	// Key and Args render Message in the language of the request; see Translate.
+	Key  MessageKey    `json:"-"`
+	Args []interface{} `json:"-"`
+
+	// Op names the operation that failed, such as player.GetPlayerByID.
+	Op string `json:"-"`
+	// Details holds structured context, such as the IDs involved.
+	Details map[string]interface{} `json:"-"`
+	// Cause is the underlying error.
+	Cause error `json:"-"`
+
+	stack []uintptr
+}
+
+// FieldError describes why a request field is invalid.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	// Key and Args render Message in the language of the request.
+	Key  MessageKey    `json:"-"`
+	Args []interface{} `json:"-"`
 }
 
-// Error returns the error message.
+// Error returns the error message, preceded by the operation and followed by the cause when set.
 func (e *Error) Error() string {
-	return fmt.Sprintf("[%s] %s", e.Code, e.Message)
+	var b strings.Builder
+	if e.Op != "" {
+		b.WriteString(e.Op)
+		b.WriteString(": ")
+	}
+	fmt.Fprintf(&b, "[%s] %s", e.Code, e.Message)
+	if e.Cause != nil {
+		if e.Message != "" {
+			b.WriteString(": ")
+		}
+		b.WriteString(e.Cause.Error())
+	}
+	return b.String()
 }
 
 // NewError creates a new custom error.
@@ -56,6 +120,33 @@ func NewErrorWithArgs(code ErrorCode, format string, args ...interface{}) *Error
 	}
 }
 
+// NewErrorWithKey creates a new custom error with a message from the catalog.
+// Message is set to the English text.
+func NewErrorWithKey(code ErrorCode, key MessageKey, args ...interface{}) *Error {
+	return &Error{
+		Code:    code,
+		Message: Translate(language.English, key, args...),
+		Key:     key,
+		Args:    args,
+	}
+}
+
+// NewFieldError creates an InvalidArgument error for a single invalid request field.
+func NewFieldError(field string, key MessageKey, args ...interface{}) *Error {
+	return NewErrorWithKey(InvalidArgumentError, MsgInvalidArgument, fieldName(field)).WithField(field, key, args...)
+}
+
+// WithField adds an invalid request field with a message from the catalog and returns the error.
+func (e *Error) WithField(field string, key MessageKey, args ...interface{}) *Error {
+	e.Fields = append(e.Fields, FieldError{
+		Field:   field,
+		Message: Translate(language.English, key, args...),
+		Key:     key,
+		Args:    args,
+	})
+	return e
+}
+
 // GetHTTPStatusCode returns the HTTP status code for an error.
 func GetHTTPStatusCode(err error) int {
 	var customErr *Error
@@ -67,12 +158,7 @@ func GetHTTPStatusCode(err error) int {
 	return http.StatusInternalServerError
 }
 
-// HandleHTTPError handles HTTP errors based on the custom error type.
+// HandleHTTPError writes err as problem details, for middleware that responds without returning an error.
 func HandleHTTPError(c echo.Context, err error) error {
-	var customErr *Error
-	if errors.As(err, &customErr) {
-		return c.JSON(GetHTTPStatusCode(err), customErr)
-	}
-	// If the error is not a custom error, return a generic internal server error.
-	return c.JSON(http.StatusInternalServerError, NewError(InternalError, "Internal server error"))
+	return writeProblem(c, err)
 }
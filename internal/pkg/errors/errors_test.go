@@ -56,6 +56,9 @@ func TestGetHTTPStatusCode(t *testing.T) {
 	code := GetHTTPStatusCode(NewError(InvalidArgumentError, ""))
 	assert.Equal(t, http.StatusBadRequest, code)
 
+	code = GetHTTPStatusCode(NewError(RateLimitedError, ""))
+	assert.Equal(t, http.StatusTooManyRequests, code)
+
 	// Test for an unknown error code.
 	code = GetHTTPStatusCode(NewError(ErrorCode("UnknownErrorCode"), ""))
 	assert.Equal(t, http.StatusInternalServerError, code)
@@ -0,0 +1,316 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+
+	"golang.org/x/text/language"
+)
+
+// MessageKey identifies a message in the catalog. Errors carry a key and its arguments so that the
+// central error handler can render the message in the language of the request.
+type MessageKey string
+
+// Message keys. The comments list the arguments of each message.
+const (
+	MsgInvalidArgument MessageKey = "invalid_argument" // field
+	MsgRequired        MessageKey = "required"
+	MsgNotEmpty        MessageKey = "not_empty"
+	MsgPastDate        MessageKey = "past_date"
+	MsgFutureDate      MessageKey = "future_date"
+
+	MsgInvalidRequestFields MessageKey = "invalid_request_fields" // number of fields
+	MsgInvalidValue         MessageKey = "invalid_value"
+	MsgMinLength            MessageKey = "min_length" // length
+	MsgMaxLength            MessageKey = "max_length" // length
+	MsgMinItems             MessageKey = "min_items"  // number of items
+	MsgMaxItems             MessageKey = "max_items"  // number of items
+	MsgMinValue             MessageKey = "min_value"  // value
+	MsgMaxValue             MessageKey = "max_value"  // value
+	MsgOneOf                MessageKey = "one_of"     // allowed values
+	MsgInvalidUUID          MessageKey = "invalid_uuid"
+	MsgInvalidLocale        MessageKey = "invalid_locale"
+	MsgDuplicateInBatch     MessageKey = "duplicate_in_batch" // index of the first item
+	MsgNotSupportedInBatch  MessageKey = "not_supported_in_batch"
+	MsgDuplicateInRoster    MessageKey = "duplicate_in_roster" // row of the first use
+	MsgExternalIDTaken      MessageKey = "external_id_taken"
+	MsgBetween              MessageKey = "between" // minimum, maximum
+
+	MsgInvalidRequestBody    MessageKey = "invalid_request_body"
+	MsgInvalidIdempotencyKey MessageKey = "invalid_idempotency_key" // maximum length
+	MsgBatchTooLarge         MessageKey = "batch_too_large"         // maximum number of players
+	MsgFileTooLarge          MessageKey = "file_too_large"          // maximum size in MB
+	MsgUnsupportedImageType  MessageKey = "unsupported_image_type"
+
+	MsgInvalidScope MessageKey = "invalid_scope" // scope
+	MsgUnknownScope MessageKey = "unknown_scope" // scope
+
+	MsgInvalidURL         MessageKey = "invalid_url" // maximum length
+	MsgForbiddenAddress   MessageKey = "forbidden_address"
+	MsgUnresolvableHost   MessageKey = "unresolvable_host" // host
+	MsgForeignStoredImage MessageKey = "foreign_stored_image"
+
+	MsgInvalidExternalIDNamespace   MessageKey = "invalid_external_id_namespace"   // namespace
+	MsgInvalidExternalIDValue       MessageKey = "invalid_external_id_value"       // namespace
+	MsgInvalidWikidataQID           MessageKey = "invalid_wikidata_qid"            // value
+	MsgDuplicateExternalIDNamespace MessageKey = "duplicate_external_id_namespace" // namespace
+	MsgConflictingExternalIDs       MessageKey = "conflicting_external_ids"        // namespaces
+
+	MsgInvalidAliasName     MessageKey = "invalid_alias_name"
+	MsgInvalidAliasType     MessageKey = "invalid_alias_type"   // type
+	MsgInvalidAliasLocale   MessageKey = "invalid_alias_locale" // locale
+	MsgPrimaryAliasLocale   MessageKey = "primary_alias_locale"
+	MsgMultiplePrimaryAlias MessageKey = "multiple_primary_alias" // locale
+	MsgDuplicateAlias       MessageKey = "duplicate_alias"        // name
+
+	MsgInvalidFields MessageKey = "invalid_fields" // field
+	MsgInvalidExpand MessageKey = "invalid_expand" // relation
+)
+
+// catalog holds the messages of one language.
+type catalog struct {
+	// messages are formatted with fmt.Sprintf.
+	messages map[MessageKey]string
+	// codes are the details of errors without a message key. English has none, so those errors keep their message.
+	codes map[ErrorCode]string
+	// statuses are the titles of problems. English uses http.StatusText.
+	statuses map[int]string
+	// fields are the names of request fields shown in messages.
+	fields map[string]string
+}
+
+// languages are the supported languages; the first is the default.
+var languages = []language.Tag{language.English, language.Korean}
+
+var languageMatcher = language.NewMatcher(languages)
+
+var catalogs = map[language.Tag]*catalog{
+	language.English: {
+		messages: map[MessageKey]string{
+			MsgInvalidArgument: "Invalid argument: %s",
+			MsgRequired:        "is required",
+			MsgNotEmpty:        "must not be empty",
+			MsgPastDate:        "must be a past date written as YYYY-MM-DD",
+			MsgFutureDate:      "must be in the future",
+
+			MsgInvalidRequestFields: "Invalid request: %d fields are invalid",
+			MsgInvalidValue:         "is invalid",
+			MsgMinLength:            "must be at least %s characters long",
+			MsgMaxLength:            "must be at most %s characters long",
+			MsgMinItems:             "must have at least %s items",
+			MsgMaxItems:             "must have at most %s items",
+			MsgMinValue:             "must be at least %s",
+			MsgMaxValue:             "must be at most %s",
+			MsgOneOf:                "must be one of %s",
+			MsgInvalidUUID:          "must be a UUID",
+			MsgInvalidLocale:        "must be a language tag such as ko or en-US",
+			MsgDuplicateInBatch:     "is already used by item %d of the batch",
+			MsgNotSupportedInBatch:  "is not supported in batch requests",
+			MsgDuplicateInRoster:    "is already used in row %d",
+			MsgExternalIDTaken:      "is already used by another player",
+			MsgBetween:              "must be between %v and %v",
+
+			MsgInvalidRequestBody:    "Invalid request body",
+			MsgInvalidIdempotencyKey: "Invalid Idempotency-Key header: at most %d characters are allowed",
+			MsgBatchTooLarge:         "Batch is too large: at most %d players are allowed",
+			MsgFileTooLarge:          "File is too large: at most %d MB is allowed",
+			MsgUnsupportedImageType:  "Image must be JPEG, PNG or WebP",
+
+			MsgInvalidScope: "Invalid scope: %s",
+			MsgUnknownScope: "unknown scope %s",
+
+			MsgInvalidURL:         "must be an absolute http or https URL without credentials, at most %d characters long",
+			MsgForbiddenAddress:   "must not target a loopback, private or reserved address",
+			MsgForeignStoredImage: "must not point to a stored image of another player",
+			MsgUnresolvableHost:   "host %s cannot be resolved",
+
+			MsgInvalidExternalIDNamespace:   "Invalid external ID namespace: %s",
+			MsgInvalidExternalIDValue:       "Invalid external ID value for %s",
+			MsgInvalidWikidataQID:           "Invalid Wikidata QID: %s",
+			MsgDuplicateExternalIDNamespace: "Duplicate external ID namespace: %s",
+			MsgConflictingExternalIDs:       "Both players have external IDs in namespaces: %s",
+
+			MsgInvalidAliasName:     "Invalid argument: alias name",
+			MsgInvalidAliasType:     "Invalid alias type: %s",
+			MsgInvalidAliasLocale:   "Invalid alias locale: %s",
+			MsgPrimaryAliasLocale:   "A primary alias needs a locale",
+			MsgMultiplePrimaryAlias: "Multiple primary aliases for locale %s",
+			MsgDuplicateAlias:       "Duplicate alias: %s",
+
+			MsgInvalidFields: "Invalid argument: fields (%s)",
+			MsgInvalidExpand: "Invalid argument: expand (%s)",
+		},
+	},
+	language.Korean: {
+		messages: map[MessageKey]string{
+			MsgInvalidArgument: "잘못된 입력: %s",
+			MsgRequired:        "필수 항목입니다",
+			MsgNotEmpty:        "비어 있을 수 없습니다",
+			MsgPastDate:        "YYYY-MM-DD 형식의 지난 날짜여야 합니다",
+			MsgFutureDate:      "미래 시각이어야 합니다",
+
+			MsgInvalidRequestFields: "잘못된 요청: %d개 항목이 올바르지 않습니다",
+			MsgInvalidValue:         "올바르지 않은 값입니다",
+			MsgMinLength:            "%s자 이상이어야 합니다",
+			MsgMaxLength:            "%s자 이하여야 합니다",
+			MsgMinItems:             "%s개 이상이어야 합니다",
+			MsgMaxItems:             "%s개 이하여야 합니다",
+			MsgMinValue:             "%s 이상이어야 합니다",
+			MsgMaxValue:             "%s 이하여야 합니다",
+			MsgOneOf:                "다음 중 하나여야 합니다: %s",
+			MsgInvalidUUID:          "UUID 형식이어야 합니다",
+			MsgInvalidLocale:        "ko, en-US 같은 언어 태그여야 합니다",
+			MsgDuplicateInBatch:     "배치의 %d번 항목에서 이미 사용되었습니다",
+			MsgNotSupportedInBatch:  "배치 요청에서는 지원하지 않습니다",
+			MsgDuplicateInRoster:    "%d행에서 이미 사용되었습니다",
+			MsgExternalIDTaken:      "다른 선수가 이미 사용하고 있습니다",
+			MsgBetween:              "%v 이상 %v 이하여야 합니다",
+
+			MsgInvalidRequestBody:    "요청 본문이 올바르지 않습니다",
+			MsgInvalidIdempotencyKey: "Idempotency-Key 헤더가 올바르지 않습니다: %d자를 넘을 수 없습니다",
+			MsgBatchTooLarge:         "배치가 너무 큽니다: 최대 %d명까지 가능합니다",
+			MsgFileTooLarge:          "파일이 너무 큽니다: 최대 %dMB까지 가능합니다",
+			MsgUnsupportedImageType:  "이미지는 JPEG, PNG 또는 WebP여야 합니다",
+
+			MsgInvalidScope: "잘못된 권한 범위: %s",
+			MsgUnknownScope: "알 수 없는 권한 범위입니다: %s",
+
+			MsgInvalidURL:         "자격 증명이 없는 http 또는 https 절대 URL이어야 하며 %d자를 넘을 수 없습니다",
+			MsgForbiddenAddress:   "루프백, 사설 또는 예약된 주소를 가리킬 수 없습니다",
+			MsgForeignStoredImage: "다른 선수의 저장된 이미지를 가리킬 수 없습니다",
+			MsgUnresolvableHost:   "호스트를 찾을 수 없습니다: %s",
+
+			MsgInvalidExternalIDNamespace:   "잘못된 외부 식별자 네임스페이스: %s",
+			MsgInvalidExternalIDValue:       "외부 식별자 값이 올바르지 않습니다: %s",
+			MsgInvalidWikidataQID:           "잘못된 Wikidata QID: %s",
+			MsgDuplicateExternalIDNamespace: "중복된 외부 식별자 네임스페이스: %s",
+			MsgConflictingExternalIDs:       "두 선수 모두 다음 네임스페이스의 외부 식별자가 있습니다: %s",
+
+			MsgInvalidAliasName:     "잘못된 입력: 별칭 이름",
+			MsgInvalidAliasType:     "잘못된 별칭 유형: %s",
+			MsgInvalidAliasLocale:   "잘못된 별칭 로케일: %s",
+			MsgPrimaryAliasLocale:   "대표 별칭에는 로케일이 필요합니다",
+			MsgMultiplePrimaryAlias: "로케일 %s의 대표 별칭이 여러 개입니다",
+			MsgDuplicateAlias:       "중복된 별칭: %s",
+
+			MsgInvalidFields: "잘못된 입력: fields (%s)",
+			MsgInvalidExpand: "잘못된 입력: expand (%s)",
+		},
+		codes: map[ErrorCode]string{
+			InvalidArgumentError:      "요청 값이 올바르지 않습니다",
+			NotFoundError:             "요청한 리소스를 찾을 수 없습니다",
+			AlreadyExistsError:        "이미 존재하는 리소스입니다",
+			FailedPreconditionError:   "다른 리소스가 참조하고 있어 처리할 수 없습니다",
+			AbortedError:              "동시에 변경된 내용과 충돌했습니다. 다시 시도해 주세요",
+			UnprocessableError:        "요청을 처리할 수 없습니다",
+			PayloadTooLargeError:      "요청 본문이 너무 큽니다",
+			UnsupportedMediaTypeError: "지원하지 않는 미디어 유형입니다",
+			UnauthenticatedError:      "인증이 필요합니다",
+			ForbiddenError:            "이 작업을 수행할 권한이 없습니다",
+			RateLimitedError:          "요청이 너무 많습니다. 잠시 후 다시 시도해 주세요",
+		},
+		statuses: map[int]string{
+			http.StatusBadRequest:            "잘못된 요청",
+			http.StatusUnauthorized:          "인증 필요",
+			http.StatusForbidden:             "권한 없음",
+			http.StatusNotFound:              "찾을 수 없음",
+			http.StatusMethodNotAllowed:      "허용되지 않는 메서드",
+			http.StatusConflict:              "충돌",
+			http.StatusRequestEntityTooLarge: "요청 본문이 너무 큼",
+			http.StatusUnsupportedMediaType:  "지원하지 않는 미디어 유형",
+			http.StatusUnprocessableEntity:   "처리할 수 없는 요청",
+			http.StatusTooManyRequests:       "요청이 너무 많음",
+			http.StatusInternalServerError:   "내부 서버 오류",
+			http.StatusServiceUnavailable:    "서비스를 사용할 수 없음",
+			http.StatusGatewayTimeout:        "시간 초과",
+		},
+		fields: map[string]string{
+			"name":              "이름",
+			"sport":             "종목",
+			"team":              "팀",
+			"birth_date":        "생년월일",
+			"profile_image_url": "프로필 이미지 URL",
+			"external_id":       "외부 식별자",
+			"owner":             "소유자",
+			"scopes":            "권한 범위",
+			"expires_at":        "만료 시각",
+			"external_ids":      "외부 식별자 목록",
+			"aliases":           "별칭 목록",
+			"duplicate_id":      "중복 선수 ID",
+			"id":                "ID",
+			"min_score":         "최소 점수",
+			"limit":             "최대 개수",
+			"grace_seconds":     "유예 시간(초)",
+			"players":           "선수 목록",
+			"format":            "형식",
+			"file":              "파일",
+			"mapping":           "열 매핑",
+			"dry_run":           "검증만 수행 여부",
+			"upsert":            "갱신 여부",
+			"image":             "이미지",
+		},
+	},
+}
+
+// fieldName is a message argument naming a request field, which is translated like messages are.
+type fieldName string
+
+// MatchLanguage returns the supported language that best matches an Accept-Language header.
+// It returns English when the header is empty or matches no supported language.
+func MatchLanguage(acceptLanguage string) language.Tag {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return languages[0]
+	}
+	_, index, confidence := languageMatcher.Match(tags...)
+	if confidence == language.No {
+		return languages[0]
+	}
+	return languages[index]
+}
+
+// Translate formats the message with the given key in lang, falling back to English when lang has
+// no such message and to the key itself when no language has.
+func Translate(lang language.Tag, key MessageKey, args ...interface{}) string {
+	c := catalogFor(lang)
+	format, ok := c.messages[key]
+	if !ok {
+		if format, ok = catalogs[language.English].messages[key]; !ok {
+			return string(key)
+		}
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+	translated := make([]interface{}, len(args))
+	for i, arg := range args {
+		if name, ok := arg.(fieldName); ok {
+			arg = c.field(string(name))
+		}
+		translated[i] = arg
+	}
+	return fmt.Sprintf(format, translated...)
+}
+
+func catalogFor(lang language.Tag) *catalog {
+	if c, ok := catalogs[lang]; ok {
+		return c
+	}
+	return catalogs[languages[0]]
+}
+
+func (c *catalog) field(name string) string {
+	if translated, ok := c.fields[name]; ok {
+		return translated
+	}
+	return name
+}
+
+// title returns the title of problems with the given status.
+func (c *catalog) title(status int) string {
+	if title, ok := c.statuses[status]; ok {
+		return title
+	}
+	return http.StatusText(status)
+}
@@ -0,0 +1,58 @@
+package errors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestMatchLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   language.Tag
+	}{
+		{"", language.English},
+		{"ko", language.Korean},
+		{"ko-KR,ko;q=0.9,en-US;q=0.8", language.Korean},
+		{"en-US,en;q=0.9,ko;q=0.8", language.English},
+		{"fr-FR,ko;q=0.5", language.Korean},
+		{"fr-FR", language.English},
+		{"*", language.English},
+		{"not a language;;", language.English},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, MatchLanguage(tt.header), tt.header)
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	assert.Equal(t, "Invalid argument: birth_date", Translate(language.English, MsgInvalidArgument, fieldName("birth_date")))
+	assert.Equal(t, "잘못된 입력: 생년월일", Translate(language.Korean, MsgInvalidArgument, fieldName("birth_date")))
+	assert.Equal(t, "잘못된 별칭 유형: nickname", Translate(language.Korean, MsgInvalidAliasType, "nickname"))
+	assert.Equal(t, "unknown", Translate(language.Korean, MessageKey("unknown")))
+}
+
+func TestCatalogsAreComplete(t *testing.T) {
+	// 모든 언어가 영어와 같은 메시지를 가져야 함
+	for _, lang := range languages {
+		for key := range catalogs[language.English].messages {
+			assert.Contains(t, catalogs[lang].messages, key, "%s: %s", lang, key)
+		}
+	}
+}
+
+func TestNewFieldError_KeepsKeys(t *testing.T) {
+	err := NewFieldError("name", MsgRequired)
+
+	assert.Equal(t, InvalidArgumentError, err.Code)
+	assert.Equal(t, "Invalid argument: name", err.Message)
+	assert.Equal(t, []FieldError{{Field: "name", Message: "is required", Key: MsgRequired}}, err.Fields)
+
+	p := NewLocalizedProblem(err, language.Korean)
+	assert.Equal(t, "잘못된 입력: 이름", p.Detail)
+	assert.Equal(t, "필수 항목입니다", p.Errors[0].Message)
+	// 원래 오류는 바뀌지 않음
+	assert.Equal(t, "is required", err.Fields[0].Message)
+}
@@ -0,0 +1,180 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/text/language"
+)
+
+// MIMEApplicationProblemJSON is the media type of problem details.
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+const (
+	headerAcceptLanguage  = "Accept-Language"
+	headerContentLanguage = "Content-Language"
+)
+
+// Problem is an RFC 7807 problem details object.
+// Its type is always about:blank, so the title is the HTTP status text; Code tells apart problems with the same status.
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"`
+	Code      ErrorCode    `json:"code"`
+	RequestID string       `json:"request_id,omitempty"`
+	TraceID   string       `json:"trace_id,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+// NewProblem describes an error as problem details in English.
+// The status of an *echo.HTTPError takes precedence over the code of the custom error it wraps.
+// Server errors get no detail, so that database error text and other internals never reach clients.
+func NewProblem(err error) *Problem {
+	return NewLocalizedProblem(err, language.English)
+}
+
+// NewLocalizedProblem is like NewProblem but renders the title and messages in lang.
+// Messages with a key are translated; in languages other than English, other messages are replaced
+// by the generic message for their code. The code itself is never translated.
+func NewLocalizedProblem(err error, lang language.Tag) *Problem {
+	p := &Problem{Type: "about:blank", Status: http.StatusInternalServerError}
+	c := catalogFor(lang)
+
+	var customErr *Error
+	if errors.As(err, &customErr) {
+		p.Status = GetHTTPStatusCode(customErr)
+		p.Code = customErr.Code
+		p.Detail = customErr.Message
+		if customErr.Key != "" {
+			p.Detail = Translate(lang, customErr.Key, customErr.Args...)
+		}
+		for _, f := range customErr.Fields {
+			if f.Key != "" {
+				f.Message = Translate(lang, f.Key, f.Args...)
+			}
+			p.Errors = append(p.Errors, f)
+		}
+	}
+
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		p.Status = httpErr.Code
+		if message, ok := httpErr.Message.(string); ok && customErr == nil {
+			p.Detail = message
+		}
+	}
+
+	if p.Code == "" {
+		p.Code = codeForStatus(p.Status)
+	}
+	if customErr == nil || customErr.Key == "" {
+		if message, ok := c.codes[p.Code]; ok {
+			p.Detail = message
+		}
+	}
+	if p.Status >= http.StatusInternalServerError {
+		p.Detail = ""
+		p.Errors = nil
+	}
+	p.Title = c.title(p.Status)
+
+	return p
+}
+
+// NewRequestProblem is like NewLocalizedProblem but renders the messages in the language of the
+// request. It is meant for errors reported inside a response, such as the failed items of a batch.
+func NewRequestProblem(c echo.Context, err error) *Problem {
+	return NewLocalizedProblem(err, RequestLanguage(c))
+}
+
+// RequestLanguage returns the supported language that best matches the Accept-Language header of the
+// request, and adds Accept-Language to the Vary header of the response.
+func RequestLanguage(c echo.Context) language.Tag {
+	c.Response().Header().Add(echo.HeaderVary, headerAcceptLanguage)
+	return MatchLanguage(c.Request().Header.Get(headerAcceptLanguage))
+}
+
+// statusDefaultCodes picks the code of statuses shared by several codes.
+var statusDefaultCodes = map[int]ErrorCode{
+	http.StatusConflict:            AlreadyExistsError,
+	http.StatusInternalServerError: InternalError,
+}
+
+// codeForStatus returns the error code of a status set by Echo or a handler rather than by a custom error.
+func codeForStatus(status int) ErrorCode {
+	if code, ok := statusDefaultCodes[status]; ok {
+		return code
+	}
+	for code, s := range errorStatusCodes {
+		if s == status {
+			return code
+		}
+	}
+	// 예: 405 → MethodNotAllowed
+	if text := http.StatusText(status); text != "" {
+		return ErrorCode(strings.ReplaceAll(strings.ReplaceAll(text, " ", ""), "-", ""))
+	}
+	return InternalError
+}
+
+// NewHTTPError converts an error into an Echo HTTP error with the matching status.
+// The error is kept as the internal error, which HTTPErrorHandler renders as problem details.
+func NewHTTPError(err error) *echo.HTTPError {
+	p := NewProblem(err)
+	message := p.Detail
+	if message == "" {
+		message = p.Title
+	}
+	return echo.NewHTTPError(p.Status, message).SetInternal(err)
+}
+
+// HTTPErrorHandler is the Echo HTTP error handler. It renders every error returned by handlers and
+// middleware as application/problem+json, and logs server errors with their full text.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+	if err := writeProblem(c, err); err != nil {
+		slog.ErrorContext(c.Request().Context(), "failed to write problem", "error", err)
+	}
+}
+
+func writeProblem(c echo.Context, err error) error {
+	lang := MatchLanguage(c.Request().Header.Get(headerAcceptLanguage))
+	p := NewLocalizedProblem(err, lang)
+	p.Instance = c.Request().URL.Path
+	p.RequestID = c.Response().Header().Get(echo.HeaderXRequestID)
+	if p.RequestID == "" {
+		p.RequestID = c.Request().Header.Get(echo.HeaderXRequestID)
+	}
+	if sc := trace.SpanContextFromContext(c.Request().Context()); sc.HasTraceID() {
+		p.TraceID = sc.TraceID().String()
+	}
+
+	if p.Status >= http.StatusInternalServerError {
+		// 로그에는 원인과 스택까지 모두 남김
+		var logged interface{} = err
+		var customErr *Error
+		if errors.As(err, &customErr) {
+			logged = customErr
+		}
+		slog.ErrorContext(c.Request().Context(), "request failed",
+			"method", c.Request().Method, "path", p.Instance, "error", fmt.Sprintf("%+v", logged))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, MIMEApplicationProblemJSON)
+	c.Response().Header().Set(headerContentLanguage, lang.String())
+	c.Response().Header().Add(echo.HeaderVary, headerAcceptLanguage)
+	if c.Request().Method == http.MethodHead {
+		return c.NoContent(p.Status)
+	}
+	return c.JSON(p.Status, p)
+}
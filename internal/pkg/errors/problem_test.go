@@ -0,0 +1,168 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewProblem(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   ErrorCode
+		detail string
+	}{
+		{"custom error", NewError(NotFoundError, "player not found"), http.StatusNotFound, NotFoundError, "player not found"},
+		{"wrapped custom error", NewHTTPError(NewError(AlreadyExistsError, "player already exists")), http.StatusConflict, AlreadyExistsError, "player already exists"},
+		{"echo error", echo.NewHTTPError(http.StatusBadRequest, "Invalid player ID"), http.StatusBadRequest, InvalidArgumentError, "Invalid player ID"},
+		{"echo conflict", echo.NewHTTPError(http.StatusConflict, "Player was modified"), http.StatusConflict, AlreadyExistsError, "Player was modified"},
+		{"retryable conflict", NewError(AbortedError, "conflicting concurrent update, please retry"), http.StatusConflict, AbortedError, "conflicting concurrent update, please retry"},
+		{"echo status without code", echo.ErrMethodNotAllowed, http.StatusMethodNotAllowed, ErrorCode("MethodNotAllowed"), "Method Not Allowed"},
+		{"plain error", fmt.Errorf("boom"), http.StatusInternalServerError, InternalError, ""},
+		// 데이터베이스 오류 내용은 노출하지 않음
+		{"database error", NewError(DatabaseError, `pq: relation "players" does not exist`), http.StatusInternalServerError, DatabaseError, ""},
+		{"echo error with database text", echo.NewHTTPError(http.StatusInternalServerError, "pq: password authentication failed"), http.StatusInternalServerError, InternalError, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewProblem(tt.err)
+
+			assert.Equal(t, "about:blank", p.Type)
+			assert.Equal(t, http.StatusText(tt.status), p.Title)
+			assert.Equal(t, tt.status, p.Status)
+			assert.Equal(t, tt.code, p.Code)
+			assert.Equal(t, tt.detail, p.Detail)
+		})
+	}
+}
+
+func TestHTTPErrorHandler(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = HTTPErrorHandler
+	e.Use(middleware.RequestID())
+	e.POST("/players", func(c echo.Context) error {
+		return NewHTTPError(NewFieldError("birth_date", MsgPastDate))
+	})
+	e.GET("/players/:id", func(c echo.Context) error {
+		return NewHTTPError(NewError(DatabaseError, "pq: connection refused"))
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/players", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-1")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+	assert.JSONEq(t, `{
+		"type": "about:blank",
+		"title": "Bad Request",
+		"status": 400,
+		"detail": "Invalid argument: birth_date",
+		"instance": "/players",
+		"code": "InvalidArgument",
+		"request_id": "req-1",
+		"errors": [{"field": "birth_date", "message": "must be a past date written as YYYY-MM-DD"}]
+	}`, rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodGet, "/players/1", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "pq:")
+	assert.Contains(t, rec.Body.String(), `"code":"DatabaseError"`)
+	assert.Contains(t, rec.Body.String(), `"request_id":"`+rec.Header().Get(echo.HeaderXRequestID)+`"`)
+
+	// 등록되지 않은 경로도 같은 형식
+	req = httptest.NewRequest(http.MethodGet, "/unknown", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+	assert.Contains(t, rec.Body.String(), `"code":"NotFound"`)
+}
+
+func TestHTTPErrorHandler_TraceID(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID})
+
+	e := echo.New()
+	e.HTTPErrorHandler = HTTPErrorHandler
+	e.GET("/players/:id", func(c echo.Context) error {
+		return NewHTTPError(NewError(NotFoundError, "player not found"))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/players/1", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req.WithContext(trace.ContextWithSpanContext(req.Context(), sc)))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"trace_id":"4bf92f3577b34da6a3ce929d0e0e4736"`)
+
+	// 추적하지 않는 요청에는 넣지 않음
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/players/1", nil))
+
+	assert.NotContains(t, rec.Body.String(), "trace_id")
+}
+
+func TestHTTPErrorHandler_Localized(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = HTTPErrorHandler
+	e.POST("/players", func(c echo.Context) error {
+		return NewHTTPError(NewFieldError("birth_date", MsgPastDate))
+	})
+	e.GET("/players/:id", func(c echo.Context) error {
+		return NewHTTPError(NewError(NotFoundError, "player not found"))
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/players", nil)
+	req.Header.Set("Accept-Language", "ko-KR,ko;q=0.9,en;q=0.8")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	// 코드와 필드 이름은 번역하지 않음
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "ko", rec.Header().Get("Content-Language"))
+	assert.Equal(t, "Accept-Language", rec.Header().Get(echo.HeaderVary))
+	assert.JSONEq(t, `{
+		"type": "about:blank",
+		"title": "잘못된 요청",
+		"status": 400,
+		"detail": "잘못된 입력: 생년월일",
+		"instance": "/players",
+		"code": "InvalidArgument",
+		"errors": [{"field": "birth_date", "message": "YYYY-MM-DD 형식의 지난 날짜여야 합니다"}]
+	}`, rec.Body.String())
+
+	// 메시지 키가 없는 오류는 코드별 메시지를 사용함
+	req = httptest.NewRequest(http.MethodGet, "/players/1", nil)
+	req.Header.Set("Accept-Language", "ko")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"detail":"요청한 리소스를 찾을 수 없습니다"`)
+	assert.Contains(t, rec.Body.String(), `"code":"NotFound"`)
+
+	// 지원하지 않는 언어는 영어로 응답함
+	req = httptest.NewRequest(http.MethodGet, "/players/1", nil)
+	req.Header.Set("Accept-Language", "fr-FR")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, "en", rec.Header().Get("Content-Language"))
+	assert.Contains(t, rec.Body.String(), `"detail":"player not found"`)
+}
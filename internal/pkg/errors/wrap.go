@@ -0,0 +1,145 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"sort"
+)
+
+// maxStackDepth is the number of frames recorded in a stack trace.
+const maxStackDepth = 32
+
+// Wrap creates an error with the given code for a failed operation, keeping err as its cause.
+// The cause stays reachable with Is and As, but is never shown to clients. A stack trace is recorded
+// for server errors. err must not be nil.
+func Wrap(code ErrorCode, op string, err error) *Error {
+	e := &Error{Code: code, Op: op, Cause: err}
+	if isServerError(code) {
+		e.stack = callers()
+	}
+	return e
+}
+
+// Wrapf is like Wrap but also sets a message for clients.
+func Wrapf(code ErrorCode, op string, err error, format string, args ...interface{}) *Error {
+	e := &Error{Code: code, Message: fmt.Sprintf(format, args...), Op: op, Cause: err}
+	if isServerError(code) {
+		e.stack = callers()
+	}
+	return e
+}
+
+func isServerError(code ErrorCode) bool {
+	status, ok := errorStatusCodes[code]
+	return !ok || status >= http.StatusInternalServerError
+}
+
+// WithDetail adds a key/value pair of context for logs and returns the error.
+func (e *Error) WithDetail(key string, value interface{}) *Error {
+	if e.Details == nil {
+		e.Details = make(map[string]interface{})
+	}
+	e.Details[key] = value
+	return e
+}
+
+// WithStack records the current stack trace, unless one was recorded already, and returns the error.
+func (e *Error) WithStack() *Error {
+	if e.stack == nil {
+		e.stack = callers()
+	}
+	return e
+}
+
+func callers() []uintptr {
+	pcs := make([]uintptr, maxStackDepth)
+	// runtime.Callers, callers 및 이를 호출한 생성 함수는 건너뜀
+	n := runtime.Callers(3, pcs)
+	return pcs[:n]
+}
+
+// StackTrace returns the frames recorded when the error was created, or nil when none were recorded.
+func (e *Error) StackTrace() []runtime.Frame {
+	if len(e.stack) == 0 {
+		return nil
+	}
+	var trace []runtime.Frame
+	frames := runtime.CallersFrames(e.stack)
+	for {
+		frame, more := frames.Next()
+		trace = append(trace, frame)
+		if !more {
+			return trace
+		}
+	}
+}
+
+// Unwrap returns the cause of the error.
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports whether target is the code of the error, so that errors.Is(err, NotFoundError) matches
+// a custom error with that code anywhere in the chain.
+func (e *Error) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && code == e.Code
+}
+
+// Format implements fmt.Formatter. %s and %v print the message; %+v also prints the details,
+// the causes and the stack trace, for logs.
+func (e *Error) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		if e.Op != "" {
+			fmt.Fprintf(s, "%s: ", e.Op)
+		}
+		fmt.Fprintf(s, "[%s]", e.Code)
+		if e.Message != "" {
+			fmt.Fprintf(s, " %s", e.Message)
+		}
+
+		keys := make([]string, 0, len(e.Details))
+		for key := range e.Details {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(s, " %s=%v", key, e.Details[key])
+		}
+
+		if e.Cause != nil {
+			fmt.Fprintf(s, "\ncaused by: %+v", e.Cause)
+		}
+		for _, frame := range e.StackTrace() {
+			fmt.Fprintf(s, "\n\t%s\n\t\t%s:%d", frame.Function, frame.File, frame.Line)
+		}
+	case verb == 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	default:
+		_, _ = io.WriteString(s, e.Error())
+	}
+}
+
+// Is reports whether any error in err's chain matches target; see errors.Is.
+// The target may be an ErrorCode.
+func Is(err, target error) bool {
+	return errors.Is(err, target)
+}
+
+// As finds the first error in err's chain that matches target; see errors.As.
+func As(err error, target interface{}) bool {
+	return errors.As(err, target)
+}
+
+// CodeOf returns the code of the first custom error in err's chain, or InternalError when there is none.
+func CodeOf(err error) ErrorCode {
+	var customErr *Error
+	if errors.As(err, &customErr) {
+		return customErr.Code
+	}
+	return InternalError
+}
@@ -0,0 +1,66 @@
+package errors
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrap(t *testing.T) {
+	cause := &pq.Error{Code: "57P01", Message: "terminating connection due to administrator command"}
+	err := Wrap(DatabaseError, "player.GetPlayerByID", cause).WithDetail("player_id", "42")
+
+	assert.Equal(t, "player.GetPlayerByID: [DatabaseError] pq: terminating connection due to administrator command", err.Error())
+
+	// 코드와 원인 모두로 확인
+	var wrapped error = fmt.Errorf("loading profile: %w", err)
+	assert.True(t, Is(wrapped, DatabaseError))
+	assert.False(t, Is(wrapped, NotFoundError))
+	var pqErr *pq.Error
+	if assert.True(t, As(wrapped, &pqErr)) {
+		assert.Equal(t, pq.ErrorCode("57P01"), pqErr.Code)
+	}
+	assert.Equal(t, DatabaseError, CodeOf(wrapped))
+	assert.Equal(t, InternalError, CodeOf(fmt.Errorf("plain")))
+
+	// 서버 오류는 스택을 기록함
+	assert.NotEmpty(t, err.StackTrace())
+	assert.Contains(t, err.StackTrace()[0].Function, "TestWrap")
+}
+
+func TestWrapf(t *testing.T) {
+	err := Wrapf(NotFoundError, "player.GetPlayerByID", sql.ErrNoRows, "player %s not found", "42")
+
+	assert.Equal(t, "player 42 not found", err.Message)
+	assert.Equal(t, "player.GetPlayerByID: [NotFound] player 42 not found: sql: no rows in result set", err.Error())
+	assert.True(t, Is(err, sql.ErrNoRows))
+	assert.True(t, Is(err, NotFoundError))
+	assert.Empty(t, err.StackTrace())
+	assert.NotEmpty(t, err.WithStack().StackTrace())
+}
+
+func TestError_Format(t *testing.T) {
+	err := Wrap(DatabaseError, "player.MergePlayers", Wrapf(NotFoundError, "player.lockPlayers", sql.ErrNoRows, "player not found")).
+		WithDetail("survivor_id", "1").
+		WithDetail("merged_id", "2")
+
+	assert.Equal(t, err.Error(), fmt.Sprintf("%v", err))
+
+	logged := fmt.Sprintf("%+v", err)
+	assert.True(t, strings.HasPrefix(logged, "player.MergePlayers: [DatabaseError] merged_id=2 survivor_id=1\ncaused by: player.lockPlayers: [NotFound] player not found\ncaused by: sql: no rows in result set"), logged)
+	assert.Contains(t, logged, "TestError_Format")
+}
+
+func TestError_JSONHidesInternals(t *testing.T) {
+	err := Wrapf(DatabaseError, "player.CreatePlayer", fmt.Errorf("pq: password authentication failed"), "failed to create player").
+		WithDetail("host", "db.internal")
+
+	data, _ := json.Marshal(err)
+
+	assert.JSONEq(t, `{"code":"DatabaseError","message":"failed to create player"}`, string(data))
+}
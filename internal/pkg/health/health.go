@@ -0,0 +1,118 @@
+// Package health reports whether the service is ready to serve requests by checking the components
+// it depends on, such as the database.
+package health
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultTimeout bounds each check when no timeout is configured.
+const DefaultTimeout = 2 * time.Second
+
+// Status is the status of the service or of one of its components.
+type Status string
+
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+)
+
+// serverComponent reports whether the server is shutting down.
+const serverComponent = "server"
+
+// Check reports whether a component works. It returns nil when the component is healthy and must
+// give up when ctx is done.
+type Check func(ctx context.Context) error
+
+// ComponentReport is the result of checking one component. Why a check failed is only logged,
+// since errors may name internal hosts and addresses.
+type ComponentReport struct {
+	Status Status `json:"status"`
+}
+
+// Report is the result of checking every component. The service is up when all of them are.
+type Report struct {
+	Status     Status                     `json:"status"`
+	Components map[string]ComponentReport `json:"components,omitempty"`
+}
+
+type component struct {
+	name  string
+	check Check
+}
+
+// Checker runs the checks of the registered components. Readiness fails once Shutdown is called.
+type Checker struct {
+	timeout      time.Duration
+	components   []component
+	shuttingDown atomic.Bool
+}
+
+// NewChecker creates a Checker that gives each check up to timeout.
+func NewChecker(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Checker{timeout: timeout}
+}
+
+// Register adds a component. Components must be registered before the checker is used.
+func (c *Checker) Register(name string, check Check) {
+	c.components = append(c.components, component{name: name, check: check})
+}
+
+// Shutdown makes readiness fail from now on, so that load balancers stop sending requests while the
+// server drains the ones in flight.
+func (c *Checker) Shutdown() {
+	c.shuttingDown.Store(true)
+}
+
+// Ready checks every component concurrently and reports their status. While shutting down the
+// components are not checked, since they may already be stopping.
+func (c *Checker) Ready(ctx context.Context) Report {
+	if c.shuttingDown.Load() {
+		return Report{
+			Status:     StatusDown,
+			Components: map[string]ComponentReport{serverComponent: {Status: StatusDown}},
+		}
+	}
+
+	reports := make([]ComponentReport, len(c.components))
+	var wg sync.WaitGroup
+	for i, comp := range c.components {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reports[i] = c.run(ctx, comp)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{
+		Status:     StatusUp,
+		Components: map[string]ComponentReport{serverComponent: {Status: StatusUp}},
+	}
+	for i, comp := range c.components {
+		report.Components[comp.name] = reports[i]
+		if reports[i].Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+	return report
+}
+
+// run runs the check of one component with the checker's timeout and logs why it failed.
+func (c *Checker) run(ctx context.Context, comp component) ComponentReport {
+	checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if err := comp.check(checkCtx); err != nil {
+		slog.WarnContext(ctx, "health check failed", "component", comp.name, "error", err)
+		return ComponentReport{Status: StatusDown}
+	}
+	return ComponentReport{Status: StatusUp}
+}
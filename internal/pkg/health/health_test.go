@@ -0,0 +1,74 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func up(context.Context) error { return nil }
+
+func TestReady(t *testing.T) {
+	checker := NewChecker(time.Second)
+	checker.Register("database", up)
+	checker.Register("cache", up)
+
+	report := checker.Ready(context.Background())
+
+	assert.Equal(t, StatusUp, report.Status)
+	assert.Equal(t, map[string]ComponentReport{
+		"server":   {Status: StatusUp},
+		"database": {Status: StatusUp},
+		"cache":    {Status: StatusUp},
+	}, report.Components)
+}
+
+func TestReady_ComponentDown(t *testing.T) {
+	checker := NewChecker(time.Second)
+	checker.Register("database", func(context.Context) error { return errors.New("connection refused") })
+	checker.Register("cache", up)
+
+	report := checker.Ready(context.Background())
+
+	assert.Equal(t, StatusDown, report.Status)
+	assert.Equal(t, ComponentReport{Status: StatusDown}, report.Components["database"])
+	assert.Equal(t, ComponentReport{Status: StatusUp}, report.Components["cache"])
+}
+
+func TestReady_Timeout(t *testing.T) {
+	checker := NewChecker(50 * time.Millisecond)
+	checker.Register("database", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	start := time.Now()
+	report := checker.Ready(context.Background())
+
+	// 응답하지 않는 구성 요소는 제한 시간이 지나면 실패로 보고함
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, StatusDown, report.Status)
+	assert.Equal(t, StatusDown, report.Components["database"].Status)
+}
+
+func TestReady_ShuttingDown(t *testing.T) {
+	checker := NewChecker(time.Second)
+	checked := false
+	checker.Register("database", func(context.Context) error {
+		checked = true
+		return nil
+	})
+
+	checker.Shutdown()
+	report := checker.Ready(context.Background())
+
+	// 종료 중에는 구성 요소를 확인하지 않고 실패로 보고함
+	assert.False(t, checked)
+	assert.Equal(t, StatusDown, report.Status)
+	assert.Equal(t, map[string]ComponentReport{
+		"server": {Status: StatusDown},
+	}, report.Components)
+}
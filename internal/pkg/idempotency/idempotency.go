@@ -0,0 +1,76 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// ErrKeyLost is returned when a request completes after its claim on the key expired
+// and another request took the key over.
+var ErrKeyLost = errors.New("idempotency key was taken over by another request")
+
+// Record is the stored state of an idempotency key.
+// A record that is not completed belongs to a request that is still in progress.
+type Record struct {
+	RequestHash string `db:"request_hash"`
+	Completed   bool   `db:"completed"`
+	StatusCode  int    `db:"status_code"`
+	// Header holds the response headers replayed with the body, such as Content-Type and Location.
+	Header http.Header `db:"-"`
+	Body   []byte      `db:"body"`
+}
+
+// Store keeps idempotency records by key.
+// Every claim is made by an owner, a token unique to the request, and only that owner may complete
+// or abandon it.
+type Store interface {
+	// Begin claims the key for the owner's request with the given hash until lockedUntil.
+	// It returns nil when the key was claimed, and the existing record otherwise.
+	// Expired records are replaced as if they did not exist.
+	Begin(ctx context.Context, key, owner, requestHash string, now, lockedUntil time.Time) (*Record, error)
+	// Complete stores the response of the owner's request until expiresAt.
+	// It returns ErrKeyLost, storing nothing, when the owner no longer holds the key.
+	Complete(ctx context.Context, key, owner string, statusCode int, header http.Header, body []byte, expiresAt time.Time) error
+	// Abandon releases a key claimed by the owner without storing a response, so that the request can be retried.
+	Abandon(ctx context.Context, key, owner string) error
+}
+
+// Sweeper is implemented by stores that delete expired records only when told to.
+type Sweeper interface {
+	// Sweep deletes the records that expired at now.
+	Sweep(ctx context.Context, now time.Time) error
+}
+
+// RunSweeps sweeps the store every interval until ctx is done.
+func RunSweeps(ctx context.Context, sweeper Sweeper, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := sweeper.Sweep(ctx, now); err != nil {
+				slog.ErrorContext(ctx, "idempotency key sweep failed", "error", err)
+			}
+		}
+	}
+}
+
+// HashRequest fingerprints a request so that a key reused for a different request can be detected.
+// The target is the request URI, including the query string.
+func HashRequest(method, target string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(target))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,98 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var jsonHeader = http.Header{"Content-Type": {"application/json"}}
+
+func TestHashRequest(t *testing.T) {
+	a := HashRequest("POST", "/players", []byte(`{"name":"김도영"}`))
+
+	assert.Equal(t, a, HashRequest("POST", "/players", []byte(`{"name":"김도영"}`)))
+	assert.NotEqual(t, a, HashRequest("POST", "/players", []byte(`{"name":"양현종"}`)))
+	assert.NotEqual(t, a, HashRequest("POST", "/players:batch", []byte(`{"name":"김도영"}`)))
+	assert.NotEqual(t, a, HashRequest("POST", "/players?upsert=true", []byte(`{"name":"김도영"}`)))
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+	now := time.Now()
+
+	record, err := store.Begin(ctx, "user:a:k1", "r1", "hash", now, now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Nil(t, record)
+
+	// 처리 중인 요청
+	record, _ = store.Begin(ctx, "user:a:k1", "r2", "hash", now, now.Add(time.Minute))
+	if assert.NotNil(t, record) {
+		assert.False(t, record.Completed)
+	}
+
+	assert.NoError(t, store.Complete(ctx, "user:a:k1", "r1", 201, jsonHeader, []byte(`{}`), now.Add(time.Hour)))
+	record, _ = store.Begin(ctx, "user:a:k1", "r3", "hash", now, now.Add(time.Minute))
+	if assert.NotNil(t, record) {
+		assert.True(t, record.Completed)
+		assert.Equal(t, 201, record.StatusCode)
+		assert.Equal(t, jsonHeader, record.Header)
+	}
+
+	// 만료된 기록은 새 요청으로 대체됨
+	record, _ = store.Begin(ctx, "user:a:k1", "r4", "other", now.Add(2*time.Hour), now.Add(2*time.Hour+time.Minute))
+	assert.Nil(t, record)
+
+	// 다른 요청의 키는 포기할 수 없음
+	assert.NoError(t, store.Abandon(ctx, "user:a:k1", "r1"))
+	record, _ = store.Begin(ctx, "user:a:k1", "r5", "other", now.Add(2*time.Hour), now.Add(2*time.Hour+time.Minute))
+	assert.NotNil(t, record)
+
+	// 포기한 키는 다시 사용할 수 있음
+	assert.NoError(t, store.Abandon(ctx, "user:a:k1", "r4"))
+	record, _ = store.Begin(ctx, "user:a:k1", "r5", "hash", now.Add(2*time.Hour), now.Add(2*time.Hour+time.Minute))
+	assert.Nil(t, record)
+}
+
+func TestMemoryStore_CompleteAfterTakeover(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+	now := time.Now()
+
+	_, _ = store.Begin(ctx, "user:a:k1", "r1", "hash", now, now.Add(30*time.Second))
+	// 잠금이 만료되어 두 번째 요청이 키를 가져감
+	later := now.Add(time.Minute)
+	record, _ := store.Begin(ctx, "user:a:k1", "r2", "hash", later, later.Add(30*time.Second))
+	assert.Nil(t, record)
+	assert.NoError(t, store.Complete(ctx, "user:a:k1", "r2", 201, jsonHeader, []byte(`{"call":2}`), later.Add(time.Hour)))
+
+	// 늦게 끝난 첫 요청은 결과를 덮어쓰지 못함
+	err := store.Complete(ctx, "user:a:k1", "r1", 201, jsonHeader, []byte(`{"call":1}`), later.Add(time.Hour))
+	assert.ErrorIs(t, err, ErrKeyLost)
+
+	record, _ = store.Begin(ctx, "user:a:k1", "r3", "hash", later, later.Add(30*time.Second))
+	if assert.NotNil(t, record) {
+		assert.Equal(t, `{"call":2}`, string(record.Body))
+	}
+}
+
+func TestMemoryStore_Sweep(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+	now := time.Now()
+
+	_, _ = store.Begin(ctx, "user:a:k1", "r1", "hash", now, now.Add(time.Second))
+	_, _ = store.Begin(ctx, "user:a:k2", "r2", "hash", now, now.Add(time.Hour))
+
+	// 정리는 주기적으로만 실행됨
+	_, _ = store.Begin(ctx, "user:a:k3", "r3", "hash", now.Add(2*time.Second), now.Add(time.Hour))
+	assert.Len(t, store.records, 3)
+
+	_, _ = store.Begin(ctx, "user:a:k4", "r4", "hash", now.Add(2*time.Minute), now.Add(time.Hour))
+	assert.Len(t, store.records, 3)
+	assert.NotContains(t, store.records, "user:a:k1")
+}
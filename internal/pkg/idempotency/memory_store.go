@@ -0,0 +1,85 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often the memory store forgets expired records.
+const sweepInterval = time.Minute
+
+type memoryRecord struct {
+	Record
+	owner     string
+	expiresAt time.Time
+}
+
+// MemoryStore keeps the records in process memory. It is only suitable for a single instance.
+type MemoryStore struct {
+	mu        sync.Mutex
+	records   map[string]*memoryRecord
+	lastSweep time.Time
+}
+
+// NewMemoryStore creates a new MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]*memoryRecord)}
+}
+
+// Begin implements Store.
+func (s *MemoryStore) Begin(_ context.Context, key, owner, requestHash string, now, lockedUntil time.Time) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		s.sweep(now)
+	}
+
+	if r, ok := s.records[key]; ok && r.expiresAt.After(now) {
+		record := r.Record
+		return &record, nil
+	}
+
+	s.records[key] = &memoryRecord{Record: Record{RequestHash: requestHash}, owner: owner, expiresAt: lockedUntil}
+	return nil, nil
+}
+
+// Complete implements Store.
+func (s *MemoryStore) Complete(_ context.Context, key, owner string, statusCode int, header http.Header, body []byte, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.records[key]
+	if !ok || r.owner != owner || r.Completed {
+		return ErrKeyLost
+	}
+	r.Completed = true
+	r.StatusCode = statusCode
+	r.Header = header
+	r.Body = body
+	r.expiresAt = expiresAt
+	return nil
+}
+
+// Abandon implements Store.
+func (s *MemoryStore) Abandon(_ context.Context, key, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r, ok := s.records[key]; ok && r.owner == owner && !r.Completed {
+		delete(s.records, key)
+	}
+	return nil
+}
+
+// sweep forgets the expired records.
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, r := range s.records {
+		if !r.expiresAt.After(now) {
+			delete(s.records, key)
+		}
+	}
+	s.lastSweep = now
+}
@@ -0,0 +1,163 @@
+// Package imaging validates uploaded images and re-encodes them into stripped, resized renditions.
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // WebP 디코더 등록
+
+	"player_management_system/internal/pkg/errors"
+)
+
+// Accepted image types.
+const (
+	TypeJPEG = "image/jpeg"
+	TypePNG  = "image/png"
+	TypeWebP = "image/webp"
+)
+
+const (
+	// maxPixels bounds the decoded size of an image, so that a small file cannot expand into
+	// gigabytes of memory.
+	maxPixels = 40_000_000
+	// MaxDimension is the longest side of the stored full-size rendition.
+	MaxDimension = 2048
+	jpegQuality  = 88
+)
+
+// Rendition is an encoded image.
+type Rendition struct {
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// Extension returns the file extension of the rendition's type.
+func (r *Rendition) Extension() string {
+	if r.ContentType == TypePNG {
+		return "png"
+	}
+	return "jpg"
+}
+
+// Result holds the renditions of a processed image.
+type Result struct {
+	// Original is the full-size rendition, at most MaxDimension pixels on its longest side.
+	Original *Rendition
+	// Thumbnails are square renditions keyed by their side in pixels.
+	Thumbnails map[int]*Rendition
+}
+
+// DetectType returns the image type of data, sniffed from its content rather than trusting the
+// declared type, or an UnsupportedMediaType error when it is not an accepted image.
+func DetectType(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case TypeJPEG, TypePNG, TypeWebP:
+		return contentType, nil
+	default:
+		return "", errors.NewErrorWithArgs(errors.UnsupportedMediaTypeError, "unsupported image type: %s", contentType)
+	}
+}
+
+// Process decodes an image, applies its EXIF orientation and re-encodes it into a full-size rendition
+// and square thumbnails of the given sizes. Re-encoding drops all metadata, including EXIF location data.
+// PNG images stay PNG to keep their transparency; JPEG and WebP images become JPEG.
+func Process(data []byte, thumbnailSizes []int) (*Result, error) {
+	contentType, err := DetectType(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrapf(errors.InvalidArgumentError, "imaging.Process", err, "invalid image")
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, errors.NewErrorWithArgs(errors.InvalidArgumentError, "image dimensions %dx%d are not allowed", cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrapf(errors.InvalidArgumentError, "imaging.Process", err, "invalid image")
+	}
+	// 방향 보정은 축소한 뒤에 적용해 큰 이미지의 픽셀 단위 처리를 피함
+	orientation := 1
+	if contentType == TypeJPEG {
+		orientation = jpegOrientation(data)
+	}
+
+	outputType := TypeJPEG
+	if contentType == TypePNG {
+		outputType = TypePNG
+	}
+
+	original, err := encode(orient(fit(img, MaxDimension), orientation), outputType)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Original: original, Thumbnails: make(map[int]*Rendition, len(thumbnailSizes))}
+	for _, size := range thumbnailSizes {
+		thumbnail, err := encode(orient(square(img, size), orientation), outputType)
+		if err != nil {
+			return nil, err
+		}
+		result.Thumbnails[size] = thumbnail
+	}
+
+	return result, nil
+}
+
+// fit scales an image down so that its longest side is at most limit pixels.
+func fit(img image.Image, limit int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= limit && h <= limit {
+		return img
+	}
+
+	if w >= h {
+		w, h = limit, max(1, h*limit/w)
+	} else {
+		w, h = max(1, w*limit/h), limit
+	}
+	return scale(img, b, w, h)
+}
+
+// square crops the center square of an image and scales it to size pixels.
+func square(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x := b.Min.X + (b.Dx()-side)/2
+	y := b.Min.Y + (b.Dy()-side)/2
+	return scale(img, image.Rect(x, y, x+side, y+side), size, size)
+}
+
+func scale(img image.Image, src image.Rectangle, w, h int) image.Image {
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, src, draw.Src, nil)
+	return dst
+}
+
+func encode(img image.Image, contentType string) (*Rendition, error) {
+	var buf bytes.Buffer
+	var err error
+	if contentType == TypePNG {
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	if err != nil {
+		return nil, errors.Wrap(errors.InternalError, "imaging.encode", err)
+	}
+
+	b := img.Bounds()
+	return &Rendition{Data: buf.Bytes(), ContentType: contentType, Width: b.Dx(), Height: b.Dy()}, nil
+}
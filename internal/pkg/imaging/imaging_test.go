@@ -0,0 +1,140 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"player_management_system/internal/pkg/errors"
+)
+
+// testImage returns a w×h image whose left half is red and right half is blue.
+func testImage(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+// withExif inserts an APP1 segment holding a big-endian EXIF orientation right after the SOI marker.
+func withExif(jpegData []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08\x00\x01")
+	entry := make([]byte, 12)
+	binary.BigEndian.PutUint16(entry[0:], exifOrientationTag)
+	binary.BigEndian.PutUint16(entry[2:], 3) // SHORT
+	binary.BigEndian.PutUint32(entry[4:], 1)
+	binary.BigEndian.PutUint16(entry[8:], orientation)
+	tiff = append(tiff, entry...)
+	tiff = append(tiff, 0, 0, 0, 0)
+	// 위치 정보가 제거되는지 확인하기 위한 표식
+	tiff = append(tiff, []byte("GPS 37.5665N 126.9780E")...)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, jpegData[:2]...)
+	out = append(out, segment...)
+	return append(out, jpegData[2:]...)
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, img, nil))
+	return buf.Bytes()
+}
+
+func TestProcess_JPEGOrientationAndExifStripped(t *testing.T) {
+	data := withExif(encodeJPEG(t, testImage(80, 40)), 6)
+	assert.Equal(t, 6, jpegOrientation(data))
+
+	result, err := Process(data, []int{32})
+	assert.NoError(t, err)
+
+	// 90도 회전되어 세로 이미지가 됨
+	assert.Equal(t, TypeJPEG, result.Original.ContentType)
+	assert.Equal(t, 40, result.Original.Width)
+	assert.Equal(t, 80, result.Original.Height)
+	assert.False(t, bytes.Contains(result.Original.Data, []byte("Exif")))
+	assert.False(t, bytes.Contains(result.Original.Data, []byte("GPS")))
+
+	// 시계 방향으로 돌리면 왼쪽(빨강)이 위로 감
+	img, err := jpeg.Decode(bytes.NewReader(result.Original.Data))
+	assert.NoError(t, err)
+	r, _, b, _ := img.At(20, 5).RGBA()
+	assert.Greater(t, r, b)
+
+	thumbnail := result.Thumbnails[32]
+	if assert.NotNil(t, thumbnail) {
+		assert.Equal(t, 32, thumbnail.Width)
+		assert.Equal(t, 32, thumbnail.Height)
+	}
+}
+
+func TestProcess_PNGStaysPNGAndIsDownscaled(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, testImage(MaxDimension*2, 100)))
+
+	result, err := Process(buf.Bytes(), []int{64, 128})
+	assert.NoError(t, err)
+	assert.Equal(t, TypePNG, result.Original.ContentType)
+	assert.Equal(t, "png", result.Original.Extension())
+	assert.Equal(t, MaxDimension, result.Original.Width)
+	assert.Equal(t, 50, result.Original.Height)
+	assert.Len(t, result.Thumbnails, 2)
+}
+
+func TestProcess_Rejected(t *testing.T) {
+	_, err := Process([]byte("<html><body>not an image</body></html>"), nil)
+	assert.Equal(t, errors.UnsupportedMediaTypeError, err.(*errors.Error).Code)
+
+	// 잘린 이미지
+	data := encodeJPEG(t, testImage(10, 10))
+	_, err = Process(data[:len(data)/2], nil)
+	assert.Equal(t, errors.InvalidArgumentError, err.(*errors.Error).Code)
+	// 디코더의 오류는 원인으로만 남고 클라이언트 메시지에는 드러나지 않음
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Equal(t, "invalid image", err.(*errors.Error).Message)
+
+	// 헤더만 거대한 이미지는 디코딩 전에 거부
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, testImage(1, 1)))
+	bomb := buf.Bytes()
+	binary.BigEndian.PutUint32(bomb[16:], 100000)
+	binary.BigEndian.PutUint32(bomb[20:], 100000)
+	binary.BigEndian.PutUint32(bomb[29:], crc32.ChecksumIEEE(bomb[12:29]))
+	_, err = Process(bomb, nil)
+	assert.Equal(t, errors.InvalidArgumentError, err.(*errors.Error).Code)
+}
+
+func TestOrient(t *testing.T) {
+	src := testImage(4, 2)
+	for orientation := 1; orientation <= 8; orientation++ {
+		out := orient(src, orientation)
+		if orientation >= 5 {
+			assert.Equal(t, image.Rect(0, 0, 2, 4), out.Bounds(), "orientation %d", orientation)
+		} else {
+			assert.Equal(t, image.Rect(0, 0, 4, 2), out.Bounds(), "orientation %d", orientation)
+		}
+	}
+
+	// 3: 180도 회전하면 오른쪽(파랑)이 왼쪽으로 옴
+	_, _, b, _ := orient(src, 3).At(0, 0).RGBA()
+	assert.Equal(t, uint32(0xffff), b)
+}
@@ -0,0 +1,136 @@
+// Package logging sets up structured logging with log/slog.
+//
+// Records logged with the context of a request carry its request ID and trace ID, so that every
+// line a request produced, from the access log to the errors of the repositories, can be found
+// together. Attributes with sensitive names, such as password, are redacted in any group; structs
+// holding secrets must implement slog.LogValuer to leave them out.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"log/slog"
+	"math"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Format names the output formats.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// redacted replaces the values of sensitive attributes.
+const redacted = "[REDACTED]"
+
+// sensitiveKeys are the attribute names whose values are never written.
+var sensitiveKeys = map[string]bool{
+	"password":      true,
+	"secret":        true,
+	"token":         true,
+	"authorization": true,
+	"api_key":       true,
+}
+
+// Config configures logging.
+type Config struct {
+	// Level is the minimum level written: debug, info, warn or error.
+	Level string
+	// Format is json or text.
+	Format string
+	// SampleRatio is the fraction of requests whose debug and info records are written. Warnings and
+	// errors, and records logged outside requests, are always written.
+	SampleRatio float64
+}
+
+// New creates a logger writing to w.
+func New(cfg Config, w io.Writer) (*slog.Logger, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q", cfg.Level)
+	}
+
+	opts := &slog.HandlerOptions{Level: level, ReplaceAttr: redact}
+	var handler slog.Handler
+	switch strings.ToLower(cfg.Format) {
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	case FormatText:
+		handler = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("invalid log format %q", cfg.Format)
+	}
+
+	return slog.New(&contextHandler{Handler: handler, sampleRatio: cfg.SampleRatio}), nil
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying a request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// redact implements slog.HandlerOptions.ReplaceAttr.
+func redact(_ []string, a slog.Attr) slog.Attr {
+	if sensitiveKeys[strings.ToLower(a.Key)] {
+		return slog.String(a.Key, redacted)
+	}
+	return a
+}
+
+// contextHandler adds the request ID and trace ID of the context to every record and samples the
+// records of requests.
+type contextHandler struct {
+	slog.Handler
+	sampleRatio float64
+}
+
+// Handle implements slog.Handler.
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	requestID, ok := RequestIDFromContext(ctx)
+	if ok {
+		if r.Level < slog.LevelWarn && !sampled(requestID, h.sampleRatio) {
+			return nil
+		}
+		r.AddAttrs(slog.String("request_id", requestID))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs), sampleRatio: h.sampleRatio}
+}
+
+// WithGroup implements slog.Handler.
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name), sampleRatio: h.sampleRatio}
+}
+
+// sampled reports whether the records of a request are written. The decision depends only on the
+// request ID, so a request is either logged in full or not at all.
+func sampled(requestID string, ratio float64) bool {
+	if ratio >= 1 {
+		return true
+	}
+	if ratio <= 0 {
+		return false
+	}
+	h := fnv.New32a()
+	h.Write([]byte(requestID))
+	return float64(h.Sum32()) < ratio*math.MaxUint32
+}
@@ -0,0 +1,132 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// decode parses the JSON lines written by a logger.
+func decode(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var records []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("invalid log line %q: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestNew_Invalid(t *testing.T) {
+	_, err := New(Config{Level: "verbose", Format: FormatJSON}, &bytes.Buffer{})
+	assert.EqualError(t, err, `invalid log level "verbose"`)
+
+	_, err = New(Config{Level: "info", Format: "xml"}, &bytes.Buffer{})
+	assert.EqualError(t, err, `invalid log format "xml"`)
+}
+
+func TestLogger_Level(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(Config{Level: "warn", Format: FormatJSON, SampleRatio: 1}, &buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	logger.Info("ignored")
+	logger.Warn("written")
+
+	records := decode(t, &buf)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, "written", records[0]["msg"])
+		assert.Equal(t, "WARN", records[0]["level"])
+	}
+}
+
+func TestLogger_Correlation(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(Config{Level: "info", Format: FormatJSON, SampleRatio: 1}, &buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := WithRequestID(context.Background(), "req-1")
+	ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID}))
+
+	logger.InfoContext(ctx, "player created", "player_id", "p-1")
+	logger.With("component", "repository").ErrorContext(ctx, "query failed")
+	logger.Info("outside a request")
+
+	records := decode(t, &buf)
+	if assert.Len(t, records, 3) {
+		assert.Equal(t, "req-1", records[0]["request_id"])
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", records[0]["trace_id"])
+		assert.Equal(t, "p-1", records[0]["player_id"])
+		assert.Equal(t, "req-1", records[1]["request_id"])
+		assert.Equal(t, "repository", records[1]["component"])
+		assert.NotContains(t, records[2], "request_id")
+		assert.NotContains(t, records[2], "trace_id")
+	}
+}
+
+func TestLogger_RedactsSensitiveFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(Config{Level: "info", Format: FormatText, SampleRatio: 1}, &buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	logger.Info("connecting", "user", "app", "password", "hunter2", "Authorization", "Bearer abc")
+	logger.WithGroup("db").Info("connecting", "password", "hunter2")
+
+	// 민감한 이름의 속성은 값을 남기지 않음
+	assert.Contains(t, buf.String(), "password=[REDACTED]")
+	assert.Contains(t, buf.String(), "Authorization=[REDACTED]")
+	assert.Contains(t, buf.String(), "db.password=[REDACTED]")
+	assert.Contains(t, buf.String(), "user=app")
+	assert.NotContains(t, buf.String(), "hunter2")
+	assert.NotContains(t, buf.String(), "Bearer abc")
+}
+
+func TestLogger_Sampling(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(Config{Level: "info", Format: FormatJSON, SampleRatio: 0.5}, &buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	kept := 0
+	for i := 0; i < 1000; i++ {
+		ctx := WithRequestID(context.Background(), fmt.Sprintf("req-%d", i))
+		before := buf.Len()
+		logger.InfoContext(ctx, "request started")
+		logger.InfoContext(ctx, "request completed")
+		logger.ErrorContext(ctx, "request failed")
+
+		records := decode(t, bytes.NewBuffer(buf.Bytes()[before:]))
+		// 요청 단위로 남기거나 버리며 오류는 항상 남김
+		if len(records) == 3 {
+			kept++
+		} else {
+			assert.Len(t, records, 1)
+			assert.Equal(t, "request failed", records[0]["msg"])
+		}
+	}
+	assert.InDelta(t, 500, kept, 100)
+
+	// 요청 밖의 기록은 표본 추출하지 않음
+	buf.Reset()
+	logger.Info("server started")
+	assert.Len(t, decode(t, &buf), 1)
+}
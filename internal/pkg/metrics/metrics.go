@@ -0,0 +1,164 @@
+// Package metrics defines the Prometheus metrics of the service and serves them for scraping.
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes the names of the metrics defined here.
+const namespace = "player_management"
+
+// refreshTimeout bounds the queries refreshing a gauge.
+const refreshTimeout = 5 * time.Second
+
+// NewRegistry creates a registry with the Go runtime and process metrics.
+func NewRegistry() *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return reg
+}
+
+// Handler serves the metrics of reg in the Prometheus exposition format. Metrics that fail to be
+// collected are left out instead of failing the scrape.
+func Handler(reg *prometheus.Registry) http.Handler {
+	return promhttp.HandlerFor(reg, promhttp.HandlerOpts{
+		ErrorHandling: promhttp.ContinueOnError,
+		Registry:      reg,
+	})
+}
+
+// HTTPMetrics counts the HTTP requests and measures their latency.
+type HTTPMetrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewHTTPMetrics creates the HTTP metrics and registers them with reg. Requests are labeled with the
+// method, the route template, such as /players/:id, and the status code.
+func NewHTTPMetrics(reg prometheus.Registerer) *HTTPMetrics {
+	labels := []string{"method", "route", "status"}
+	m := &HTTPMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Number of HTTP requests handled.",
+		}, labels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of HTTP requests.",
+			Buckets:   prometheus.DefBuckets,
+		}, labels),
+	}
+	reg.MustRegister(m.requests, m.duration)
+	return m
+}
+
+// Observe records a handled request.
+func (m *HTTPMetrics) Observe(method, route string, status int, duration time.Duration) {
+	code := strconv.Itoa(status)
+	m.requests.WithLabelValues(method, route, code).Inc()
+	m.duration.WithLabelValues(method, route, code).Observe(duration.Seconds())
+}
+
+// RepositoryMetrics measures the duration of repository methods.
+type RepositoryMetrics struct {
+	duration *prometheus.HistogramVec
+}
+
+// NewRepositoryMetrics creates the repository metrics and registers them with reg. Calls are labeled
+// with the repository, the method and whether it returned an error.
+func NewRepositoryMetrics(reg prometheus.Registerer) *RepositoryMetrics {
+	m := &RepositoryMetrics{
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "repository",
+			Name:      "query_duration_seconds",
+			Help:      "Duration of repository method calls.",
+			Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+		}, []string{"repository", "method", "result"}),
+	}
+	reg.MustRegister(m.duration)
+	return m
+}
+
+// Observe records a call that started at start and returned err.
+func (m *RepositoryMetrics) Observe(repository, method string, start time.Time, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	m.duration.WithLabelValues(repository, method, result).Observe(time.Since(start).Seconds())
+}
+
+// CountFunc counts items by a label value, such as players by sport.
+type CountFunc func(ctx context.Context) (map[string]int, error)
+
+// CountGauge reports the counts of a CountFunc as a gauge. The counts are refreshed by Run rather
+// than on every scrape, so that scrapes never query the database.
+type CountGauge struct {
+	gauge *prometheus.GaugeVec
+	count CountFunc
+}
+
+// NewPlayersGauge creates a gauge reporting the number of players per sport, which count returns,
+// and registers it with reg.
+func NewPlayersGauge(reg prometheus.Registerer, count CountFunc) *CountGauge {
+	g := &CountGauge{
+		gauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "players",
+			Help:      "Number of players per sport.",
+		}, []string{"sport"}),
+		count: count,
+	}
+	reg.MustRegister(g.gauge)
+	return g
+}
+
+// Refresh replaces the reported counts with new ones. The previous counts are kept when counting fails.
+func (g *CountGauge) Refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, refreshTimeout)
+	defer cancel()
+
+	counts, err := g.count(ctx)
+	if err != nil {
+		return err
+	}
+	// 사라진 라벨 값이 남지 않도록 모두 지우고 다시 설정
+	g.gauge.Reset()
+	for label, n := range counts {
+		g.gauge.WithLabelValues(label).Set(float64(n))
+	}
+	return nil
+}
+
+// Run refreshes the counts right away and then every interval until ctx is done.
+func (g *CountGauge) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := g.Refresh(ctx); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "failed to refresh metric", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := NewHTTPMetrics(reg)
+
+	m.Observe(http.MethodGet, "/players/:id", http.StatusOK, 10*time.Millisecond)
+	m.Observe(http.MethodGet, "/players/:id", http.StatusOK, 20*time.Millisecond)
+	m.Observe(http.MethodGet, "/players/:id", http.StatusNotFound, time.Millisecond)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.requests.WithLabelValues("GET", "/players/:id", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.requests.WithLabelValues("GET", "/players/:id", "404")))
+	assert.Equal(t, 2, testutil.CollectAndCount(m.duration))
+}
+
+func TestRepositoryMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := NewRepositoryMetrics(reg)
+
+	m.Observe("player", "GetPlayerByID", time.Now(), nil)
+	m.Observe("player", "GetPlayerByID", time.Now(), nil)
+	m.Observe("player", "GetPlayerByID", time.Now(), errors.New("boom"))
+
+	families, err := reg.Gather()
+	assert.NoError(t, err)
+	if assert.Len(t, families, 1) {
+		assert.Equal(t, "player_management_repository_query_duration_seconds", families[0].GetName())
+
+		// 버킷은 실행 시간에 따라 달라지므로 결과별 호출 수만 확인함
+		counts := make(map[string]uint64)
+		for _, metric := range families[0].GetMetric() {
+			labels := make(map[string]string)
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			assert.Equal(t, "player", labels["repository"])
+			assert.Equal(t, "GetPlayerByID", labels["method"])
+			counts[labels["result"]] = metric.GetHistogram().GetSampleCount()
+		}
+		assert.Equal(t, map[string]uint64{"ok": 2, "error": 1}, counts)
+	}
+}
+
+func TestPlayersGauge(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	counts := map[string]int{"Football": 3, "Basketball": 1}
+	var countErr error
+	calls := 0
+	g := NewPlayersGauge(reg, func(context.Context) (map[string]int, error) {
+		calls++
+		return counts, countErr
+	})
+
+	assert.NoError(t, g.Refresh(context.Background()))
+	// 수집할 때는 다시 세지 않음
+	err := testutil.GatherAndCompare(reg, strings.NewReader(`
+# HELP player_management_players Number of players per sport.
+# TYPE player_management_players gauge
+player_management_players{sport="Basketball"} 1
+player_management_players{sport="Football"} 3
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	// 실패하면 이전 값을 유지함
+	countErr = errors.New("connection refused")
+	assert.Error(t, g.Refresh(context.Background()))
+	assert.Equal(t, float64(3), testutil.ToFloat64(g.gauge.WithLabelValues("Football")))
+
+	// 없어진 종목은 지표에서 빠짐
+	counts, countErr = map[string]int{"Football": 4}, nil
+	assert.NoError(t, g.Refresh(context.Background()))
+	assert.Equal(t, 1, testutil.CollectAndCount(g.gauge))
+}
+
+// failingCollector fails to collect its metric.
+type failingCollector struct {
+	desc *prometheus.Desc
+}
+
+func (c failingCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c failingCollector) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.NewInvalidMetric(c.desc, errors.New("connection refused"))
+}
+
+func TestHandler_ContinuesOnCollectError(t *testing.T) {
+	reg := NewRegistry()
+	NewHTTPMetrics(reg).Observe(http.MethodGet, "/players", http.StatusOK, time.Millisecond)
+	reg.MustRegister(failingCollector{desc: prometheus.NewDesc("player_management_players", "Number of players per sport.", nil, nil)})
+
+	rec := httptest.NewRecorder()
+	Handler(reg).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	// 실패한 지표만 빠지고 나머지는 그대로 내보냄
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `player_management_http_requests_total{method="GET",route="/players",status="200"} 1`)
+	assert.Contains(t, rec.Body.String(), "go_goroutines")
+	assert.NotContains(t, rec.Body.String(), "player_management_players{")
+}
@@ -0,0 +1,112 @@
+// Package pgerr translates PostgreSQL errors into domain errors, so that a constraint violation or an
+// unavailable database is reported with a meaningful status instead of a generic database error.
+package pgerr
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"net"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"player_management_system/internal/pkg/errors"
+)
+
+// SQLSTATE values and classes; see https://www.postgresql.org/docs/current/errcodes-appendix.html.
+const (
+	UniqueViolation      = "23505"
+	ForeignKeyViolation  = "23503"
+	SerializationFailure = "40001"
+	DeadlockDetected     = "40P01"
+	LockNotAvailable     = "55P03"
+	QueryCanceled        = "57014"
+	AdminShutdown        = "57P01"
+	CrashShutdown        = "57P02"
+	CannotConnectNow     = "57P03"
+
+	classDataException        = "22"
+	classIntegrityViolation   = "23"
+	classConnectionException  = "08"
+	classInsufficientResource = "53"
+)
+
+// Wrap translates err, returned by the database during op, into a domain error that keeps err as
+// its cause:
+//
+//   - unique_violation is AlreadyExists;
+//   - foreign_key_violation is InvalidArgument when the referenced row does not exist, and
+//     FailedPrecondition when the row is still referenced by others;
+//   - other integrity violations and invalid data are InvalidArgument;
+//   - serialization_failure, deadlock_detected and lock_not_available are Aborted, which clients
+//     may retry;
+//   - query_canceled and expired deadlines are Timeout;
+//   - connection failures and shutdowns are NotConnected;
+//   - anything else is DatabaseError.
+//
+// A custom error, such as one returned from within a transaction, is returned as is. err must not be nil.
+func Wrap(op string, err error) *errors.Error {
+	var customErr *errors.Error
+	if errors.As(err, &customErr) {
+		return customErr
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return wrapPQ(op, err, pqErr)
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return errors.Wrapf(errors.TimeoutError, op, err, "database query timed out")
+	case isConnectionError(err):
+		return errors.Wrapf(errors.NotConnectedError, op, err, "database is unavailable")
+	}
+	return errors.Wrap(errors.DatabaseError, op, err)
+}
+
+func wrapPQ(op string, err error, pqErr *pq.Error) *errors.Error {
+	var e *errors.Error
+	switch code := string(pqErr.Code); {
+	case code == UniqueViolation:
+		e = errors.Wrapf(errors.AlreadyExistsError, op, err, "resource already exists")
+	case code == ForeignKeyViolation:
+		// 삭제 시에는 참조하는 행이 남아 있는 경우이고, 추가 시에는 참조 대상이 없는 경우임
+		if strings.Contains(pqErr.Detail, "is still referenced") {
+			e = errors.Wrapf(errors.FailedPreconditionError, op, err, "resource is still referenced by other resources")
+		} else {
+			e = errors.Wrapf(errors.InvalidArgumentError, op, err, "referenced resource does not exist")
+		}
+	case strings.HasPrefix(code, classIntegrityViolation), strings.HasPrefix(code, classDataException):
+		e = errors.Wrapf(errors.InvalidArgumentError, op, err, "invalid value")
+	case code == SerializationFailure, code == DeadlockDetected, code == LockNotAvailable:
+		e = errors.Wrapf(errors.AbortedError, op, err, "conflicting concurrent update, please retry")
+	case code == QueryCanceled:
+		e = errors.Wrapf(errors.TimeoutError, op, err, "database query timed out")
+	case strings.HasPrefix(code, classConnectionException), strings.HasPrefix(code, classInsufficientResource),
+		code == AdminShutdown, code == CrashShutdown, code == CannotConnectNow:
+		e = errors.Wrapf(errors.NotConnectedError, op, err, "database is unavailable")
+	default:
+		e = errors.Wrap(errors.DatabaseError, op, err)
+	}
+
+	// 제약 조건과 테이블 이름은 로그에만 남김
+	e.WithDetail("sqlstate", string(pqErr.Code))
+	if pqErr.Constraint != "" {
+		e.WithDetail("constraint", pqErr.Constraint)
+	}
+	if pqErr.Table != "" {
+		e.WithDetail("table", pqErr.Table)
+	}
+	return e
+}
+
+// isConnectionError reports whether err means the database could not be reached or the connection was lost.
+func isConnectionError(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
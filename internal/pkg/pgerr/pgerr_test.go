@@ -0,0 +1,52 @@
+package pgerr
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"player_management_system/internal/pkg/errors"
+)
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code errors.ErrorCode
+	}{
+		{"unique violation", &pq.Error{Code: UniqueViolation}, errors.AlreadyExistsError},
+		{"wrapped pq error", fmt.Errorf("insert: %w", &pq.Error{Code: DeadlockDetected}), errors.AbortedError},
+		{"lock not available", &pq.Error{Code: LockNotAvailable}, errors.AbortedError},
+		{"deadline exceeded", fmt.Errorf("query: %w", context.DeadlineExceeded), errors.TimeoutError},
+		{"bad connection", driver.ErrBadConn, errors.NotConnectedError},
+		{"other", fmt.Errorf("sql: Scan error"), errors.DatabaseError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Wrap("player.CreatePlayer", tt.err)
+
+			assert.Equal(t, tt.code, err.Code)
+			assert.Equal(t, "player.CreatePlayer", err.Op)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestWrap_Details(t *testing.T) {
+	err := Wrap("player.CreatePlayer", &pq.Error{Code: UniqueViolation, Constraint: "player_external_ids_pkey", Table: "player_external_ids"})
+
+	// 제약 조건 이름은 메시지가 아니라 로그용 세부 정보에만 들어감
+	assert.Equal(t, "resource already exists", err.Message)
+	assert.Equal(t, map[string]interface{}{"sqlstate": "23505", "constraint": "player_external_ids_pkey", "table": "player_external_ids"}, err.Details)
+}
+
+func TestWrap_KeepsCustomErrors(t *testing.T) {
+	notFound := errors.NewError(errors.NotFoundError, "player not found")
+
+	assert.Same(t, notFound, Wrap("player.MergePlayers", fmt.Errorf("lock: %w", notFound)))
+}
@@ -0,0 +1,93 @@
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"time"
+)
+
+// Limit describes a token bucket holding up to Burst tokens that refills at Rate tokens per second.
+// Every request takes one token.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Result is the outcome of taking a token from a bucket.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter is how long to wait for the next token; zero when the request was allowed.
+	RetryAfter time.Duration
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+}
+
+// Store keeps token buckets by key.
+// Implementations must take tokens atomically so that concurrent requests are counted correctly.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit, now time.Time) (Result, error)
+}
+
+// Sweeper is implemented by stores that forget full buckets only when told to.
+type Sweeper interface {
+	// Sweep deletes the buckets that are full at now, which behave like new ones.
+	Sweep(ctx context.Context, now time.Time) error
+}
+
+// RunSweeps sweeps the store every interval until ctx is done.
+func RunSweeps(ctx context.Context, sweeper Sweeper, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := sweeper.Sweep(ctx, now); err != nil {
+				slog.ErrorContext(ctx, "rate limit sweep failed", "error", err)
+			}
+		}
+	}
+}
+
+// Bucket is the state of a token bucket. A zero Bucket is full.
+type Bucket struct {
+	Tokens    float64   `db:"tokens"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// Take refills the bucket up to now and takes a token if one is available.
+func (b *Bucket) Take(limit Limit, now time.Time) Result {
+	burst := float64(limit.Burst)
+	if b.UpdatedAt.IsZero() {
+		b.Tokens = burst
+	} else if elapsed := now.Sub(b.UpdatedAt).Seconds(); elapsed > 0 {
+		b.Tokens = math.Min(burst, b.Tokens+elapsed*limit.Rate)
+	}
+	b.UpdatedAt = now
+
+	result := Result{Limit: limit.Burst}
+	if b.Tokens >= 1 {
+		b.Tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = seconds((1 - b.Tokens) / limit.Rate)
+	}
+	result.Remaining = int(b.Tokens)
+	result.Reset = seconds((burst - b.Tokens) / limit.Rate)
+
+	return result
+}
+
+// full reports whether the bucket would be full at now, in which case it can be forgotten.
+func (b *Bucket) full(limit Limit, now time.Time) bool {
+	return b.Tokens+now.Sub(b.UpdatedAt).Seconds()*limit.Rate >= float64(limit.Burst)
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucket_Take(t *testing.T) {
+	limit := Limit{Rate: 1, Burst: 2}
+	now := time.Now()
+	var b Bucket
+
+	r := b.Take(limit, now)
+	assert.True(t, r.Allowed)
+	assert.Equal(t, 1, r.Remaining)
+	assert.Equal(t, 2, r.Limit)
+
+	r = b.Take(limit, now)
+	assert.True(t, r.Allowed)
+	assert.Equal(t, 0, r.Remaining)
+
+	r = b.Take(limit, now)
+	assert.False(t, r.Allowed)
+	assert.Equal(t, time.Second, r.RetryAfter)
+	assert.Equal(t, 2*time.Second, r.Reset)
+
+	// 0.5초 후에는 아직 토큰이 없음
+	r = b.Take(limit, now.Add(500*time.Millisecond))
+	assert.False(t, r.Allowed)
+	assert.Equal(t, 500*time.Millisecond, r.RetryAfter)
+
+	r = b.Take(limit, now.Add(time.Second))
+	assert.True(t, r.Allowed)
+
+	// 오래 기다려도 버스트 이상으로 채워지지 않음
+	r = b.Take(limit, now.Add(time.Hour))
+	assert.True(t, r.Allowed)
+	assert.Equal(t, 1, r.Remaining)
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore()
+	limit := Limit{Rate: 1, Burst: 1}
+	now := time.Now()
+	ctx := context.Background()
+
+	r, err := store.Take(ctx, "read:key:a", limit, now)
+	assert.NoError(t, err)
+	assert.True(t, r.Allowed)
+
+	r, _ = store.Take(ctx, "read:key:a", limit, now)
+	assert.False(t, r.Allowed)
+
+	// 다른 클라이언트는 영향을 받지 않음
+	r, _ = store.Take(ctx, "read:key:b", limit, now)
+	assert.True(t, r.Allowed)
+
+	// 가득 찬 버킷은 정리됨
+	store.Take(ctx, "read:key:c", limit, now.Add(time.Hour))
+	assert.Len(t, store.buckets, 1)
+}
@@ -0,0 +1,132 @@
+// Package romanize transcribes Korean names written in Hangul to the Latin alphabet following
+// the Revised Romanization of Korean.
+package romanize
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Hangul syllables are composed of an initial consonant, a vowel and an optional final consonant.
+const (
+	syllableBase  = 0xAC00
+	syllableLast  = 0xD7A3
+	medialCount   = 21
+	finalCount    = 28
+	initialStride = medialCount * finalCount
+)
+
+var (
+	initials = [...]string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	medials  = [...]string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+	finals   = [...]string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+)
+
+// compoundSurnames are the two-syllable family names, which are otherwise hard to tell apart
+// from a one-syllable family name followed by a given name.
+var compoundSurnames = map[string]bool{
+	"남궁": true, "독고": true, "동방": true, "사공": true,
+	"서문": true, "선우": true, "제갈": true, "황보": true,
+}
+
+// customarySurnames are the spellings most holders of common family names use in passports,
+// which differ from the Revised Romanization.
+var customarySurnames = map[string]string{
+	"김": "Kim", "이": "Lee", "박": "Park", "최": "Choi", "정": "Jung",
+	"조": "Cho", "윤": "Yoon", "임": "Lim", "오": "Oh", "신": "Shin",
+	"권": "Kwon", "안": "Ahn", "류": "Ryu", "유": "Yoo", "노": "Noh",
+	"우": "Woo", "구": "Koo", "주": "Joo", "성": "Sung", "문": "Moon",
+}
+
+// IsHangul reports whether s is made only of Hangul syllables and spaces, with at least one syllable.
+func IsHangul(s string) bool {
+	found := false
+	for _, r := range s {
+		switch {
+		case r >= syllableBase && r <= syllableLast:
+			found = true
+		case unicode.IsSpace(r):
+		default:
+			return false
+		}
+	}
+	return found
+}
+
+// Name romanizes a Korean name as the family name followed by the given name, whose syllables
+// are joined by hyphens, e.g. 김도영 becomes "Gim Do-yeong". As the rules require for names,
+// sound changes between syllables are not transcribed. It returns false when name is not Hangul.
+func Name(name string) (string, bool) {
+	family, given, ok := splitName(name)
+	if !ok {
+		return "", false
+	}
+	return join(capitalize(word(family)), given), true
+}
+
+// CustomaryName romanizes a Korean name like Name but spells common family names the customary way,
+// e.g. 김도영 becomes "Kim Do-yeong".
+func CustomaryName(name string) (string, bool) {
+	family, given, ok := splitName(name)
+	if !ok {
+		return "", false
+	}
+	surname, ok := customarySurnames[string(family)]
+	if !ok {
+		surname = capitalize(word(family))
+	}
+	return join(surname, given), true
+}
+
+// splitName separates the family name from the given name. A space marks the boundary when present;
+// otherwise the family name is the first syllable, or the first two for a compound family name.
+func splitName(name string) (family, given []rune, ok bool) {
+	name = strings.TrimSpace(name)
+	if !IsHangul(name) {
+		return nil, nil, false
+	}
+
+	if i := strings.IndexFunc(name, unicode.IsSpace); i >= 0 {
+		return []rune(name[:i]), []rune(strings.Join(strings.Fields(name[i:]), "")), true
+	}
+
+	runes := []rune(name)
+	if len(runes) >= 3 && compoundSurnames[string(runes[:2])] {
+		return runes[:2], runes[2:], true
+	}
+	return runes[:1], runes[1:], true
+}
+
+func join(surname string, given []rune) string {
+	if len(given) == 0 {
+		return surname
+	}
+
+	syllables := make([]string, 0, len(given))
+	for _, r := range given {
+		syllables = append(syllables, syllable(r))
+	}
+	return surname + " " + capitalize(strings.Join(syllables, "-"))
+}
+
+// word romanizes consecutive syllables without separators.
+func word(runes []rune) string {
+	var b strings.Builder
+	for _, r := range runes {
+		b.WriteString(syllable(r))
+	}
+	return b.String()
+}
+
+// syllable romanizes a single Hangul syllable.
+func syllable(r rune) string {
+	code := int(r - syllableBase)
+	return initials[code/initialStride] + medials[code%initialStride/finalCount] + finals[code%finalCount]
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
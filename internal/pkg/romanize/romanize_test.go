@@ -0,0 +1,51 @@
+package romanize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		ok       bool
+	}{
+		{"김도영", "Gim Do-yeong", true},
+		{"류현진", "Ryu Hyeon-jin", true},
+		{"이정후", "I Jeong-hu", true},
+		{"남궁민", "Namgung Min", true},
+		{"선우 정아", "Seonu Jeong-a", true},
+		{"김복남", "Gim Bok-nam", true},
+		{"오", "O", true},
+		{"Kim Do-yeong", "", false},
+		{"김도영2", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Name(tt.name)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestCustomaryName(t *testing.T) {
+	got, ok := CustomaryName("김도영")
+	assert.True(t, ok)
+	assert.Equal(t, "Kim Do-yeong", got)
+
+	// 관용 표기가 없는 성은 개정 로마자 표기를 사용
+	got, ok = CustomaryName("양현종")
+	assert.True(t, ok)
+	assert.Equal(t, "Yang Hyeon-jong", got)
+}
+
+func TestIsHangul(t *testing.T) {
+	assert.True(t, IsHangul("김 도영"))
+	assert.False(t, IsHangul("김 Doyoung"))
+	assert.False(t, IsHangul(" "))
+}
@@ -0,0 +1,157 @@
+// Package safehttp validates and fetches URLs supplied by clients without letting them reach
+// loopback, private or other internal addresses (server-side request forgery).
+package safehttp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// MaxURLLength is the longest URL accepted.
+const MaxURLLength = 2048
+
+// maxRedirects is the number of redirects a client follows before giving up.
+const maxRedirects = 5
+
+// ErrForbiddenAddress is returned when a URL resolves to an address that is not publicly routable.
+var ErrForbiddenAddress = errors.New("URL must not target a loopback, private or reserved address")
+
+// reservedNetworks are publicly unroutable ranges not covered by the net.IP predicates.
+var reservedNetworks = mustParseCIDRs(
+	"0.0.0.0/8",       // "this" network
+	"100.64.0.0/10",   // carrier-grade NAT
+	"192.0.0.0/24",    // IETF protocol assignments
+	"192.0.2.0/24",    // TEST-NET-1
+	"198.18.0.0/15",   // benchmarking
+	"198.51.100.0/24", // TEST-NET-2
+	"203.0.113.0/24",  // TEST-NET-3
+	"240.0.0.0/4",     // reserved, including broadcast
+	"64:ff9b::/96",    // NAT64, which can embed any IPv4 address
+	"2001:db8::/32",   // documentation
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = network
+	}
+	return networks
+}
+
+// IsPublicIP reports whether ip is a publicly routable unicast address.
+func IsPublicIP(ip net.IP) bool {
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, network := range reservedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseURL checks that raw is an absolute http or https URL with a host and without credentials.
+// It does not resolve the host; see CheckURL.
+func ParseURL(raw string) (*url.URL, error) {
+	if len(raw) > MaxURLLength {
+		return nil, fmt.Errorf("URL is longer than %d characters", MaxURLLength)
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, errors.New("URL is malformed")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.New("URL must use http or https")
+	}
+	if u.Hostname() == "" {
+		return nil, errors.New("URL must have a host")
+	}
+	if u.User != nil {
+		return nil, errors.New("URL must not contain credentials")
+	}
+	return u, nil
+}
+
+// CheckURL parses raw like ParseURL and resolves its host, returning ErrForbiddenAddress when the
+// host is a loopback name or any of its addresses is not public.
+//
+// The result can change when DNS does, so clients from NewClient check addresses again on connect.
+func CheckURL(ctx context.Context, resolver *net.Resolver, raw string) error {
+	u, err := ParseURL(raw)
+	if err != nil {
+		return err
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrForbiddenAddress
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicIP(ip) {
+			return ErrForbiddenAddress
+		}
+		return nil
+	}
+
+	addrs, err := resolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return fmt.Errorf("URL host %s cannot be resolved", host)
+	}
+	for _, addr := range addrs {
+		if !IsPublicIP(addr.IP) {
+			return ErrForbiddenAddress
+		}
+	}
+	return nil
+}
+
+// NewClient returns an HTTP client that refuses to connect to addresses that are not public.
+// The address is checked when the connection is made, after DNS resolution, so that neither a
+// redirect nor a DNS answer that changed since CheckURL can reach an internal service.
+// Proxies from the environment are ignored, since they would hide the address connected to.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if !IsPublicIP(net.ParseIP(host)) {
+				return ErrForbiddenAddress
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   timeout,
+			ResponseHeaderTimeout: timeout,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			_, err := ParseURL(req.URL.String())
+			return err
+		},
+	}
+}
@@ -0,0 +1,166 @@
+// Package validation checks request bodies against the rules declared in their validate struct tags
+// and reports every violated field at once.
+//
+// Besides the rules of github.com/go-playground/validator, these are available:
+//
+//   - pastdate: a date written as YYYY-MM-DD that is not in the future;
+//   - future: a time.Time after now;
+//   - imageurl: a root-relative path or an absolute http or https URL without credentials.
+package validation
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/safehttp"
+)
+
+// embedded names the anonymous struct fields, which are left out of field paths.
+const embedded = "<embedded>"
+
+// messageKeys are the messages of the rules without arguments.
+var messageKeys = map[string]errors.MessageKey{
+	"required":           errors.MsgRequired,
+	"uuid":               errors.MsgInvalidUUID,
+	"bcp47_language_tag": errors.MsgInvalidLocale,
+	"pastdate":           errors.MsgPastDate,
+	"future":             errors.MsgFutureDate,
+}
+
+// Validator validates structs with validate tags. It implements echo.Validator.
+type Validator struct {
+	validate *validator.Validate
+}
+
+// New creates a Validator. Fields are named after their JSON names.
+func New() *Validator {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		if f.Anonymous {
+			return embedded
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	// 등록은 태그 이름이 겹칠 때만 실패함
+	_ = v.RegisterValidation("pastdate", isPastDate)
+	_ = v.RegisterValidation("future", isFuture)
+	_ = v.RegisterValidation("imageurl", isImageURL)
+
+	return &Validator{validate: v}
+}
+
+// Validate checks i, which must be a struct or a pointer to one. When fields are invalid it returns an
+// InvalidArgument error listing each of them with a message from the catalog.
+func (v *Validator) Validate(i interface{}) error {
+	err := v.validate.Struct(i)
+	if err == nil {
+		return nil
+	}
+
+	var violations validator.ValidationErrors
+	if !errors.As(err, &violations) {
+		return errors.Wrap(errors.InternalError, "validation.Validate", err)
+	}
+
+	if len(violations) == 1 {
+		key, args := message(violations[0])
+		return errors.NewFieldError(fieldPath(violations[0].Namespace()), key, args...)
+	}
+	e := errors.NewErrorWithKey(errors.InvalidArgumentError, errors.MsgInvalidRequestFields, len(violations))
+	for _, violation := range violations {
+		key, args := message(violation)
+		e.WithField(fieldPath(violation.Namespace()), key, args...)
+	}
+	return e
+}
+
+// fieldPath turns the namespace of a violation, such as CreatePlayerRequest.aliases[0].name, into the
+// path of the field in the request body, aliases[0].name.
+func fieldPath(namespace string) string {
+	segments := strings.Split(namespace, ".")[1:]
+	path := segments[:0]
+	for _, segment := range segments {
+		if segment != embedded {
+			path = append(path, segment)
+		}
+	}
+	return strings.Join(path, ".")
+}
+
+// message returns the message key and arguments describing a violation.
+func message(violation validator.FieldError) (errors.MessageKey, []interface{}) {
+	param := violation.Param()
+	switch violation.Tag() {
+	case "min", "max":
+		return sizeMessage(violation.Tag(), violation.Kind(), param)
+	case "gte":
+		return errors.MsgMinValue, []interface{}{param}
+	case "lte":
+		return errors.MsgMaxValue, []interface{}{param}
+	case "oneof":
+		return errors.MsgOneOf, []interface{}{strings.Join(strings.Fields(param), ", ")}
+	case "imageurl":
+		return errors.MsgInvalidURL, []interface{}{safehttp.MaxURLLength}
+	}
+	if key, ok := messageKeys[violation.Tag()]; ok {
+		return key, nil
+	}
+	return errors.MsgInvalidValue, nil
+}
+
+// sizeMessage describes a violated size rule, which limits the length of strings, the number of
+// items of slices and maps and the value of numbers.
+func sizeMessage(tag string, kind reflect.Kind, param string) (errors.MessageKey, []interface{}) {
+	args := []interface{}{param}
+	switch kind {
+	case reflect.String:
+		if tag == "min" {
+			return errors.MsgMinLength, args
+		}
+		return errors.MsgMaxLength, args
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if tag == "min" {
+			return errors.MsgMinItems, args
+		}
+		return errors.MsgMaxItems, args
+	default:
+		if tag == "min" {
+			return errors.MsgMinValue, args
+		}
+		return errors.MsgMaxValue, args
+	}
+}
+
+// isPastDate implements the pastdate rule.
+func isPastDate(fl validator.FieldLevel) bool {
+	t, err := time.Parse(time.DateOnly, fl.Field().String())
+	return err == nil && !t.After(time.Now())
+}
+
+// isFuture implements the future rule.
+func isFuture(fl validator.FieldLevel) bool {
+	t, ok := fl.Field().Interface().(time.Time)
+	return ok && t.After(time.Now())
+}
+
+// isImageURL implements the imageurl rule. Root-relative paths point to images we serve ourselves.
+func isImageURL(fl validator.FieldLevel) bool {
+	raw := fl.Field().String()
+	if len(raw) > safehttp.MaxURLLength {
+		return false
+	}
+	if strings.HasPrefix(raw, "/") && !strings.HasPrefix(raw, "//") {
+		return true
+	}
+	_, err := safehttp.ParseURL(raw)
+	return err == nil
+}
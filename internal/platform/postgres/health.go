@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SchemaVersion is the schema version the application needs. It must be raised together with the
+// version recorded at the end of test/integration/testdata/init.sql whenever the schema changes.
+const SchemaVersion = 3
+
+// HealthCheck returns a function that pings the database and checks that its schema is current,
+// giving up when the context is done. Readiness probes call it with a deadline.
+func HealthCheck(db *sqlx.DB) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if err := db.PingContext(ctx); err != nil {
+			return fmt.Errorf("ping failed: %w", err)
+		}
+		return checkSchemaVersion(ctx, db)
+	}
+}
+
+// checkSchemaVersion reports a database whose recorded schema version is older than SchemaVersion.
+// Newer versions are accepted, so that the schema can be migrated before the application is rolled out.
+func checkSchemaVersion(ctx context.Context, db *sqlx.DB) error {
+	var version sql.NullInt64
+	if err := db.GetContext(ctx, &version, `SELECT MAX(version) FROM schema_version`); err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if !version.Valid {
+		return errors.New("schema version is not recorded")
+	}
+	if version.Int64 < SchemaVersion {
+		return fmt.Errorf("schema version %d is older than %d", version.Int64, SchemaVersion)
+	}
+	return nil
+}
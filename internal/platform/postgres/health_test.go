@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+const schemaVersionQuery = `SELECT MAX(version) FROM schema_version`
+
+func newMockDB(t *testing.T) (*sqlx.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return sqlx.NewDb(db, "sqlmock"), mock
+}
+
+func versionRows(version interface{}) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"max"}).AddRow(version)
+}
+
+func TestHealthCheck(t *testing.T) {
+	for _, version := range []int{SchemaVersion, SchemaVersion + 1} {
+		db, mock := newMockDB(t)
+		mock.ExpectPing()
+		mock.ExpectQuery(regexp.QuoteMeta(schemaVersionQuery)).WillReturnRows(versionRows(version))
+
+		// 새 버전의 스키마는 배포 전에 먼저 적용될 수 있음
+		err := HealthCheck(db)(context.Background())
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	}
+}
+
+func TestHealthCheck_PingFailed(t *testing.T) {
+	db, mock := newMockDB(t)
+	mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+
+	err := HealthCheck(db)(context.Background())
+
+	// 연결에 실패하면 스키마는 확인하지 않음
+	assert.EqualError(t, err, "ping failed: connection refused")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHealthCheck_SchemaOutOfDate(t *testing.T) {
+	db, mock := newMockDB(t)
+	mock.ExpectPing()
+	mock.ExpectQuery(regexp.QuoteMeta(schemaVersionQuery)).WillReturnRows(versionRows(SchemaVersion - 1))
+
+	err := HealthCheck(db)(context.Background())
+
+	assert.EqualError(t, err, fmt.Sprintf("schema version %d is older than %d", SchemaVersion-1, SchemaVersion))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHealthCheck_SchemaVersionMissing(t *testing.T) {
+	db, mock := newMockDB(t)
+	mock.ExpectPing()
+	mock.ExpectQuery(regexp.QuoteMeta(schemaVersionQuery)).WillReturnRows(versionRows(nil))
+
+	err := HealthCheck(db)(context.Background())
+
+	assert.EqualError(t, err, "schema version is not recorded")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,11 +1,13 @@
 package postgres
 
 import (
+	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq" //  `sql.Open`에서 `postgres` driver를 사용하기 위해 import
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Config represents the configuration for the Postgres database.
@@ -15,21 +17,45 @@ type Config struct {
 	User     string
 	Password string
 	DBName   string
+	// TracerProvider, when set, traces every statement run within a traced request.
+	TracerProvider trace.TracerProvider
 }
 
-// New creates a new SQLX database connection.
+// LogValue implements slog.LogValuer. The password is left out, so the configuration can be logged.
+func (cfg Config) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("host", cfg.Host),
+		slog.String("port", cfg.Port),
+		slog.String("user", cfg.User),
+		slog.String("database", cfg.DBName),
+	)
+}
+
+// New creates a new SQLX database connection. HealthCheck reports whether it is still usable.
 func New(cfg Config) (*sqlx.DB, error) {
 	dsn := fmt.Sprintf(
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
 		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DBName,
 	)
 
-	db, err := sqlx.Connect("postgres", dsn)
+	var sqlDB *sql.DB
+	var err error
+	if cfg.TracerProvider != nil {
+		sqlDB, err = openTraced("postgres", dsn, cfg.TracerProvider)
+	} else {
+		sqlDB, err = sql.Open("postgres", dsn)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	log.Println("Connected to PostgreSQL database!")
+	db := sqlx.NewDb(sqlDB, "postgres")
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	slog.Info("connected to database", "db", cfg)
 
 	return db, nil
 }
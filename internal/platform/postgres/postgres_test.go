@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	logger.Info("connecting", "db", Config{Host: "db.internal", Port: "5432", User: "app", Password: "hunter2", DBName: "players"})
+
+	// 비밀번호는 어떤 형식으로도 남기지 않음
+	assert.Contains(t, buf.String(), `"db":{"host":"db.internal","port":"5432","user":"app","database":"players"}`)
+	assert.NotContains(t, buf.String(), "hunter2")
+}
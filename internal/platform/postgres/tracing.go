@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"strings"
+
+	"github.com/XSAM/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// literalPattern matches the placeholders, string literals and numeric literals of a statement.
+var literalPattern = regexp.MustCompile(`\$\d+|'(?:[^']|'')*'|\b\d+(?:\.\d+)?\b`)
+
+// openTraced opens a database whose statements are recorded as spans with a redacted db.statement
+// attribute. Arguments are never recorded, and statements run outside a traced request, such as
+// those of the background jobs, make no span.
+func openTraced(driverName, dsn string, tp trace.TracerProvider) (*sql.DB, error) {
+	return otelsql.Open(driverName, dsn,
+		otelsql.WithTracerProvider(tp),
+		otelsql.WithAttributes(semconv.DBSystemPostgreSQL),
+		otelsql.WithAttributesGetter(statementAttributes),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			DisableQuery:         true,
+			DisableErrSkip:       true,
+			OmitConnResetSession: true,
+			OmitRows:             true,
+			SpanFilter: func(ctx context.Context, _ otelsql.Method, _ string, _ []driver.NamedValue) bool {
+				return trace.SpanContextFromContext(ctx).IsValid()
+			},
+		}),
+	)
+}
+
+// statementAttributes sets db.statement to the redacted statement.
+func statementAttributes(_ context.Context, _ otelsql.Method, query string, _ []driver.NamedValue) []attribute.KeyValue {
+	if query == "" {
+		return nil
+	}
+	return []attribute.KeyValue{semconv.DBStatement(redactStatement(query))}
+}
+
+// redactStatement replaces the literals of a statement with ? and collapses its whitespace, so that
+// spans show the shape of the statement but none of its values. Placeholders are kept.
+func redactStatement(query string) string {
+	redacted := literalPattern.ReplaceAllStringFunc(query, func(match string) string {
+		if strings.HasPrefix(match, "$") {
+			return match
+		}
+		return "?"
+	})
+	return strings.Join(strings.Fields(redacted), " ")
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestRedactStatement(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"SELECT id FROM players WHERE id = $1", "SELECT id FROM players WHERE id = $1"},
+		{"SELECT id FROM players WHERE name = 'Son' AND team = 'It''s'", "SELECT id FROM players WHERE name = ? AND team = ?"},
+		{"FETCH FORWARD 500 FROM player_stream", "FETCH FORWARD ? FROM player_stream"},
+		{"SELECT v1 FROM t WHERE x > 1.5\n   LIMIT $2", "SELECT v1 FROM t WHERE x > ? LIMIT $2"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, redactStatement(tt.query))
+	}
+}
+
+func TestOpenTraced(t *testing.T) {
+	_, mock, err := sqlmock.NewWithDSN("traced")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	db, err := openTraced("sqlmock", "traced", tp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE players`).WithArgs("secret").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM players`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	ctx, span := tp.Tracer("test").Start(context.Background(), "request")
+	_, err = db.ExecContext(ctx, `UPDATE players SET name = $1 WHERE sport = 'Football'`, "secret")
+	assert.NoError(t, err)
+	span.End()
+
+	// 요청 밖에서 실행한 문장은 추적하지 않음
+	_, err = db.ExecContext(context.Background(), `DELETE FROM players`)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 2) {
+		statement := spans[0]
+		assert.Equal(t, span.SpanContext().SpanID(), statement.Parent.SpanID())
+		assert.Contains(t, statement.Attributes, attribute.String("db.statement", "UPDATE players SET name = $1 WHERE sport = ?"))
+		assert.Contains(t, statement.Attributes, attribute.String("db.system", "postgresql"))
+		for _, attr := range statement.Attributes {
+			assert.NotContains(t, attr.Value.Emit(), "secret")
+			assert.NotContains(t, attr.Value.Emit(), "Football")
+		}
+		assert.Equal(t, "request", spans[1].Name)
+	}
+}
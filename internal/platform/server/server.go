@@ -0,0 +1,152 @@
+// Package server runs the HTTP server together with the background workers and shuts them down in order.
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DefaultShutdownTimeout bounds draining in-flight requests and stopping workers when no timeout is configured.
+const DefaultShutdownTimeout = 30 * time.Second
+
+// Worker is a background job that runs until its context is done.
+type Worker func(ctx context.Context)
+
+// Config configures Run.
+type Config struct {
+	// Addr is the address to listen on. It is ignored when the Echo listener is already set.
+	Addr string
+	// ShutdownTimeout bounds the time given to in-flight requests and workers to finish.
+	ShutdownTimeout time.Duration
+	// OnShutdown is called when shutdown starts, before the server stops accepting connections.
+	// It is meant to make readiness probes fail.
+	OnShutdown func()
+	// ShutdownDelay is how long the server keeps accepting connections after OnShutdown, giving load
+	// balancers time to see the failing readiness probe and stop sending requests.
+	ShutdownDelay time.Duration
+}
+
+// Run starts the server and the workers and blocks until ctx is done or the server fails. It then
+// shuts down in order:
+//
+//  1. calls OnShutdown and keeps serving for ShutdownDelay;
+//  2. stops accepting connections and waits for in-flight requests to complete;
+//  3. stops the workers, which requests may have handed work to, and waits for them to return;
+//  4. closes the closers, such as the database pool, in the order given.
+//
+// Requests and workers still running when the shutdown timeout expires are abandoned. Run returns
+// the error that made the server fail, or the first error met while shutting down.
+func Run(ctx context.Context, e *echo.Echo, cfg Config, workers []Worker, closers ...io.Closer) error {
+	if cfg.ShutdownTimeout <= 0 {
+		cfg.ShutdownTimeout = DefaultShutdownTimeout
+	}
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	var wg sync.WaitGroup
+	for _, worker := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			worker(workerCtx)
+		}()
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- e.Start(cfg.Addr)
+	}()
+
+	var err error
+	stopped := false
+	select {
+	case <-ctx.Done():
+		if cfg.OnShutdown != nil {
+			cfg.OnShutdown()
+		}
+		if cfg.ShutdownDelay > 0 {
+			slog.Info("shutting down, failing readiness", "delay", cfg.ShutdownDelay)
+			time.Sleep(cfg.ShutdownDelay)
+		}
+		slog.Info("shutting down, draining requests", "timeout", cfg.ShutdownTimeout)
+	case startErr := <-serveErr:
+		// 서버가 시작하지 못했거나 중단된 경우에도 작업과 연결은 정리함
+		stopped = true
+		err = fmt.Errorf("server failed: %w", startErr)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	if shutdownErr := e.Shutdown(shutdownCtx); shutdownErr != nil && err == nil {
+		err = fmt.Errorf("failed to drain requests: %w", shutdownErr)
+	}
+	if !stopped {
+		if startErr := <-serveErr; startErr != nil && !errors.Is(startErr, http.ErrServerClosed) && err == nil {
+			err = fmt.Errorf("server failed: %w", startErr)
+		}
+	}
+
+	stopWorkers()
+	if waitErr := wait(shutdownCtx, &wg); waitErr != nil && err == nil {
+		err = fmt.Errorf("failed to stop workers: %w", waitErr)
+	}
+
+	for _, closer := range closers {
+		if closeErr := closer.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("failed to close: %w", closeErr)
+		}
+	}
+
+	return err
+}
+
+// Serve returns a worker serving e at addr, for a secondary server such as the admin server of the
+// metrics. Stopping the worker shuts the server down, giving in-flight requests DefaultShutdownTimeout.
+// A worker cannot make Run fail, so a server that fails to start or stops is logged.
+func Serve(e *echo.Echo, addr string) Worker {
+	return func(ctx context.Context) {
+		serveErr := make(chan error, 1)
+		go func() {
+			serveErr <- e.Start(addr)
+		}()
+
+		select {
+		case err := <-serveErr:
+			slog.Error("server failed", "addr", addr, "error", err)
+			return
+		case <-ctx.Done():
+		}
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), DefaultShutdownTimeout)
+		defer cancel()
+		if err := e.Shutdown(shutdownCtx); err != nil {
+			slog.Error("failed to shut down server", "addr", addr, "error", err)
+		}
+		<-serveErr
+	}
+}
+
+// wait waits for wg until ctx is done.
+func wait(ctx context.Context, wg *sync.WaitGroup) error {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
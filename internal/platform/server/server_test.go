@@ -0,0 +1,233 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder records the order in which workers stop and closers are closed.
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *recorder) add(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *recorder) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.events...)
+}
+
+func (r *recorder) worker(ctx context.Context) {
+	<-ctx.Done()
+	r.add("worker stopped")
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error { return f() }
+
+func (r *recorder) closer(name string) io.Closer {
+	return closerFunc(func() error {
+		r.add(name + " closed")
+		return nil
+	})
+}
+
+// newSlowServer serves GET /slow, which blocks until release is closed.
+func newSlowServer(t *testing.T) (e *echo.Echo, started, release chan struct{}) {
+	e = echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+	started = make(chan struct{})
+	release = make(chan struct{})
+	e.GET("/slow", func(c echo.Context) error {
+		close(started)
+		<-release
+		return c.String(http.StatusOK, "done")
+	})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.Listener = ln
+	return e, started, release
+}
+
+func TestRun_DrainsInFlightRequests(t *testing.T) {
+	e, started, release := newSlowServer(t)
+	addr := e.Listener.Addr().String()
+	events := &recorder{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- Run(ctx, e, Config{ShutdownTimeout: 5 * time.Second}, []Worker{events.worker}, events.closer("db"))
+	}()
+
+	type result struct {
+		status int
+		body   string
+		err    error
+	}
+	responses := make(chan result, 1)
+	go func() {
+		resp, err := http.Get("http://" + addr + "/slow")
+		if err != nil {
+			responses <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		responses <- result{status: resp.StatusCode, body: string(body), err: err}
+	}()
+
+	// 요청이 처리되는 중에 종료를 시작함
+	<-started
+	cancel()
+
+	// 새 연결은 받지 않지만 처리 중인 요청과 작업은 그대로 유지됨
+	assert.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+		}
+		return err != nil
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Empty(t, events.get())
+
+	close(release)
+
+	r := <-responses
+	if assert.NoError(t, r.err) {
+		assert.Equal(t, http.StatusOK, r.status)
+		assert.Equal(t, "done", r.body)
+	}
+	assert.NoError(t, <-runErr)
+	assert.Equal(t, []string{"worker stopped", "db closed"}, events.get())
+}
+
+func TestRun_ShutdownTimeout(t *testing.T) {
+	e, started, release := newSlowServer(t)
+	defer close(release)
+	events := &recorder{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- Run(ctx, e, Config{ShutdownTimeout: 100 * time.Millisecond}, nil, events.closer("db"))
+	}()
+
+	go func() {
+		resp, err := http.Get("http://" + e.Listener.Addr().String() + "/slow")
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+	<-started
+	cancel()
+
+	// 시간 안에 끝나지 않은 요청은 포기하지만 연결은 닫음
+	assert.ErrorIs(t, <-runErr, context.DeadlineExceeded)
+	assert.Equal(t, []string{"db closed"}, events.get())
+}
+
+func TestRun_StartFailure(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+	events := &recorder{}
+
+	// 이미 사용 중인 주소
+	err = Run(context.Background(), e, Config{Addr: ln.Addr().String()}, []Worker{events.worker}, events.closer("db"))
+
+	assert.ErrorContains(t, err, "server failed")
+	assert.Equal(t, []string{"worker stopped", "db closed"}, events.get())
+}
+
+func TestRun_FailsReadinessBeforeDraining(t *testing.T) {
+	e, _, _ := newSlowServer(t)
+	addr := e.Listener.Addr().String()
+	events := &recorder{}
+	readinessFailed := make(chan struct{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- Run(ctx, e, Config{
+			ShutdownTimeout: 5 * time.Second,
+			OnShutdown: func() {
+				events.add("readiness failed")
+				close(readinessFailed)
+			},
+			ShutdownDelay: 200 * time.Millisecond,
+		}, []Worker{events.worker}, events.closer("db"))
+	}()
+
+	cancel()
+	<-readinessFailed
+
+	// 지연 시간 동안은 새 연결도 계속 받음
+	conn, err := net.Dial("tcp", addr)
+	if assert.NoError(t, err) {
+		conn.Close()
+	}
+
+	assert.NoError(t, <-runErr)
+	assert.Equal(t, []string{"readiness failed", "worker stopped", "db closed"}, events.get())
+}
+
+func TestServe(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+	e.Listener = ln
+	e.GET("/metrics", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		Serve(e, "")(ctx)
+		close(done)
+	}()
+
+	resp, err := http.Get("http://" + ln.Addr().String() + "/metrics")
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	// 작업을 멈추면 서버도 종료됨
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return after the worker was stopped")
+	}
+	_, err = http.Get("http://" + ln.Addr().String() + "/metrics")
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+// Package tracing sets up OpenTelemetry tracing and exports the spans to a collector over OTLP/HTTP.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// closeTimeout bounds exporting the spans still buffered when the provider is closed.
+const closeTimeout = 5 * time.Second
+
+// Config configures tracing.
+type Config struct {
+	// ServiceName names the service in the exported spans.
+	ServiceName string
+	// Endpoint is the URL of the OTLP/HTTP collector, such as http://localhost:4318. When it is empty
+	// no span is exported, but requests still get a trace ID for the logs and error responses.
+	Endpoint string
+	// SampleRatio is the fraction of new traces that are recorded. Traces continued from an incoming
+	// traceparent header follow the sampling decision of the caller.
+	SampleRatio float64
+}
+
+// Provider is a TracerProvider that exports the spans still buffered when it is closed.
+type Provider struct {
+	*sdktrace.TracerProvider
+}
+
+// New creates a Provider.
+func New(cfg Config) (*Provider, error) {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(cfg.ServiceName))),
+	}
+	if cfg.Endpoint != "" {
+		exporter, err := otlptracehttp.New(context.Background(), otlptracehttp.WithEndpointURL(cfg.Endpoint))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create span exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	return &Provider{TracerProvider: sdktrace.NewTracerProvider(opts...)}, nil
+}
+
+// Close implements io.Closer. It must be closed after everything that makes spans.
+func (p *Provider) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+
+	if err := p.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to export spans: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew_WithoutEndpoint(t *testing.T) {
+	p, err := New(Config{ServiceName: "test", SampleRatio: 1})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, span := p.Tracer("test").Start(context.Background(), "request")
+	span.End()
+
+	// 내보내지 않아도 추적 ID는 만들어짐
+	assert.True(t, span.SpanContext().HasTraceID())
+	assert.True(t, span.SpanContext().IsSampled())
+	assert.NoError(t, p.Close())
+}
+
+func TestNew_SampleRatio(t *testing.T) {
+	p, err := New(Config{ServiceName: "test", SampleRatio: 0})
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer p.Close()
+
+	_, span := p.Tracer("test").Start(context.Background(), "request")
+	span.End()
+
+	// 기록하지 않는 추적도 로그와 오류 응답에 쓸 ID는 가짐
+	assert.True(t, span.SpanContext().HasTraceID())
+	assert.False(t, span.SpanContext().IsSampled())
+}
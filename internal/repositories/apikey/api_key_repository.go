@@ -0,0 +1,20 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"player_management_system/internal/domains/apikeys"
+)
+
+// APIKeyRepository defines the interface for API key repository operations.
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key *apikey.APIKey) error
+	GetAPIKeyByID(ctx context.Context, id uuid.UUID) (*apikey.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, hash string) (*apikey.APIKey, error)
+	GetAPIKeys(ctx context.Context) ([]*apikey.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID, at time.Time) error
+	RotateAPIKey(ctx context.Context, id uuid.UUID, replacement *apikey.APIKey, revokeAt time.Time) error
+	UpdateLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error
+}
@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"player_management_system/internal/domains/apikeys"
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/pgerr"
+	apiKeyRepo "player_management_system/internal/repositories/apikey"
+)
+
+type apiKeyRepository struct {
+	db *sqlx.DB
+}
+
+func NewAPIKeyRepository(db *sqlx.DB) apiKeyRepo.APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+// apiKeyRow is the database form of an API key; scopes are stored as a TEXT[] column.
+type apiKeyRow struct {
+	apikey.APIKey
+	Scopes pq.StringArray `db:"scopes"`
+}
+
+func (row *apiKeyRow) toDomain() *apikey.APIKey {
+	k := row.APIKey
+	k.Scopes = []string(row.Scopes)
+	return &k
+}
+
+const apiKeyColumns = `id, name, owner, prefix, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at`
+
+// CreateAPIKey implements apiKeyRepo.APIKeyRepository.
+func (r *apiKeyRepository) CreateAPIKey(ctx context.Context, k *apikey.APIKey) error {
+	if r.db == nil {
+		return errors.NewError(errors.NotConnectedError, "")
+	}
+
+	if err := insertAPIKey(ctx, r.db, k); err != nil {
+		return pgerr.Wrap("apiKey.CreateAPIKey", err)
+	}
+
+	return nil
+}
+
+// GetAPIKeyByID implements apiKeyRepo.APIKeyRepository.
+func (r *apiKeyRepository) GetAPIKeyByID(ctx context.Context, id uuid.UUID) (*apikey.APIKey, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	query := `
+        SELECT ` + apiKeyColumns + `
+        FROM api_keys
+        WHERE id = $1
+    `
+	return r.get(ctx, query, id)
+}
+
+// GetAPIKeyByHash implements apiKeyRepo.APIKeyRepository.
+func (r *apiKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (*apikey.APIKey, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	query := `
+        SELECT ` + apiKeyColumns + `
+        FROM api_keys
+        WHERE key_hash = $1
+    `
+	return r.get(ctx, query, hash)
+}
+
+// GetAPIKeys implements apiKeyRepo.APIKeyRepository.
+func (r *apiKeyRepository) GetAPIKeys(ctx context.Context) ([]*apikey.APIKey, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	var rows []apiKeyRow
+	query := `
+        SELECT ` + apiKeyColumns + `
+        FROM api_keys
+        ORDER BY created_at DESC
+    `
+	if err := r.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, pgerr.Wrap("apiKey.GetAPIKeys", err)
+	}
+
+	keys := make([]*apikey.APIKey, 0, len(rows))
+	for i := range rows {
+		keys = append(keys, rows[i].toDomain())
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey implements apiKeyRepo.APIKeyRepository.
+// A key that is already revoked keeps its earlier revocation time unless at is sooner.
+func (r *apiKeyRepository) RevokeAPIKey(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if r.db == nil {
+		return errors.NewError(errors.NotConnectedError, "")
+	}
+
+	return revokeAPIKey(ctx, r.db, "apiKey.RevokeAPIKey", id, at)
+}
+
+// RotateAPIKey implements apiKeyRepo.APIKeyRepository.
+// The replacement is stored and the old key revoked in one transaction, so a failed rotation
+// leaves neither a second key nor a shortened old one behind.
+func (r *apiKeyRepository) RotateAPIKey(ctx context.Context, id uuid.UUID, replacement *apikey.APIKey, revokeAt time.Time) error {
+	if r.db == nil {
+		return errors.NewError(errors.NotConnectedError, "")
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return pgerr.Wrap("apiKey.RotateAPIKey", err)
+	}
+	defer tx.Rollback()
+
+	if err := insertAPIKey(ctx, tx, replacement); err != nil {
+		return pgerr.Wrap("apiKey.RotateAPIKey", err)
+	}
+	if err := revokeAPIKey(ctx, tx, "apiKey.RotateAPIKey", id, revokeAt); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return pgerr.Wrap("apiKey.RotateAPIKey", err)
+	}
+	return nil
+}
+
+// UpdateLastUsed implements apiKeyRepo.APIKeyRepository.
+func (r *apiKeyRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if r.db == nil {
+		return errors.NewError(errors.NotConnectedError, "")
+	}
+
+	query := `
+        UPDATE api_keys
+        SET last_used_at = $2
+        WHERE id = $1
+    `
+
+	if _, err := r.db.ExecContext(ctx, query, id, at); err != nil {
+		return pgerr.Wrap("apiKey.UpdateLastUsed", err)
+	}
+
+	return nil
+}
+
+func insertAPIKey(ctx context.Context, db sqlx.ExecerContext, k *apikey.APIKey) error {
+	query := `
+        INSERT INTO api_keys (id, name, owner, prefix, key_hash, scopes, expires_at, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    `
+
+	_, err := db.ExecContext(
+		ctx,
+		query,
+		k.ID,
+		k.Name,
+		k.Owner,
+		k.Prefix,
+		k.Hash,
+		pq.StringArray(k.Scopes),
+		k.ExpiresAt,
+		k.CreatedAt,
+	)
+	return err
+}
+
+// revokeAPIKey runs the revocation of RevokeAPIKey on db, which may be a transaction.
+func revokeAPIKey(ctx context.Context, db sqlx.ExecerContext, op string, id uuid.UUID, at time.Time) error {
+	query := `
+        UPDATE api_keys
+        SET revoked_at = LEAST(COALESCE(revoked_at, $2), $2)
+        WHERE id = $1
+    `
+
+	result, err := db.ExecContext(ctx, query, id, at)
+	if err != nil {
+		return pgerr.Wrap(op, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return pgerr.Wrap(op, err)
+	}
+	if rowsAffected == 0 {
+		return errors.NewErrorWithArgs(errors.NotFoundError, "api key not found")
+	}
+
+	return nil
+}
+
+func (r *apiKeyRepository) get(ctx context.Context, query string, arg interface{}) (*apikey.APIKey, error) {
+	var row apiKeyRow
+	err := r.db.GetContext(ctx, &row, query, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrapf(errors.NotFoundError, "apiKey.get", err, "api key not found")
+		}
+		return nil, pgerr.Wrap("apiKey.get", err)
+	}
+	return row.toDomain(), nil
+}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"player_management_system/internal/domains/apikeys"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAPIKeyRepository(sqlx.NewDb(db, "sqlmock"))
+
+	k, _, err := apikey.NewAPIKey("partner", "acme", []string{apikey.ScopePlayersRead}, nil)
+	assert.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO api_keys`)).
+		WithArgs(k.ID, k.Name, k.Owner, k.Prefix, k.Hash, pq.StringArray{apikey.ScopePlayersRead}, k.ExpiresAt, k.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.CreateAPIKey(context.Background(), k)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAPIKeyByHash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAPIKeyRepository(sqlx.NewDb(db, "sqlmock"))
+
+	id := uuid.New()
+	rows := sqlmock.NewRows([]string{"id", "name", "owner", "prefix", "key_hash", "scopes", "expires_at", "last_used_at", "revoked_at", "created_at"}).
+		AddRow(id, "partner", "acme", "pms_12345678", "hash", "{players:read,media:write}", nil, nil, nil, time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM api_keys WHERE key_hash = $1`)).
+		WithArgs("hash").
+		WillReturnRows(rows)
+
+	k, err := repo.GetAPIKeyByHash(context.Background(), "hash")
+	assert.NoError(t, err)
+	assert.Equal(t, id, k.ID)
+	assert.Equal(t, []string{apikey.ScopePlayersRead, apikey.ScopeMediaWrite}, k.Scopes)
+	assert.Nil(t, k.ExpiresAt)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAPIKeyByHash_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAPIKeyRepository(sqlx.NewDb(db, "sqlmock"))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM api_keys WHERE key_hash = $1`)).
+		WithArgs("missing").
+		WillReturnError(sql.ErrNoRows)
+
+	k, err := repo.GetAPIKeyByHash(context.Background(), "missing")
+	assert.Nil(t, k)
+
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.NotFoundError, customErr.Code)
+	}
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevokeAPIKey_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAPIKeyRepository(sqlx.NewDb(db, "sqlmock"))
+
+	id := uuid.New()
+	now := time.Now()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE api_keys SET revoked_at`)).
+		WithArgs(id, now).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.RevokeAPIKey(context.Background(), id, now)
+
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.NotFoundError, customErr.Code)
+	}
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRotateAPIKey_RolledBackWhenOldKeyIsMissing(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAPIKeyRepository(sqlx.NewDb(db, "sqlmock"))
+
+	k, _, err := apikey.NewAPIKey("partner", "acme", []string{apikey.ScopePlayersRead}, nil)
+	assert.NoError(t, err)
+	id := uuid.New()
+	revokeAt := time.Now().Add(time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO api_keys`)).
+		WithArgs(k.ID, k.Name, k.Owner, k.Prefix, k.Hash, pq.StringArray{apikey.ScopePlayersRead}, k.ExpiresAt, k.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE api_keys SET revoked_at`)).
+		WithArgs(id, revokeAt).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = repo.RotateAPIKey(context.Background(), id, k, revokeAt)
+
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.NotFoundError, customErr.Code)
+	}
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
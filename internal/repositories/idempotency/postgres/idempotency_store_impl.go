@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/idempotency"
+	"player_management_system/internal/pkg/pgerr"
+)
+
+// idempotencyStore keeps the idempotency records in Postgres so that all replicas share them.
+type idempotencyStore struct {
+	db *sqlx.DB
+}
+
+func NewIdempotencyStore(db *sqlx.DB) idempotency.Store {
+	return &idempotencyStore{db: db}
+}
+
+// Begin implements idempotency.Store.
+// The key is claimed with a single upsert that only replaces expired rows,
+// so two replicas can never both claim it.
+func (s *idempotencyStore) Begin(ctx context.Context, key, owner, requestHash string, now, lockedUntil time.Time) (*idempotency.Record, error) {
+	if s.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	claimQuery := `
+        INSERT INTO idempotency_keys (key, owner, request_hash, completed, status_code, headers, body, expires_at)
+        VALUES ($1, $2, $3, FALSE, 0, '{}', NULL, $4)
+        ON CONFLICT (key) DO UPDATE
+        SET owner = EXCLUDED.owner,
+            request_hash = EXCLUDED.request_hash,
+            completed = FALSE,
+            status_code = 0,
+            headers = '{}',
+            body = NULL,
+            expires_at = EXCLUDED.expires_at
+        WHERE idempotency_keys.expires_at <= $5
+        RETURNING key
+    `
+
+	var claimed string
+	err := s.db.GetContext(ctx, &claimed, claimQuery, key, owner, requestHash, lockedUntil, now)
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, pgerr.Wrap("idempotency.Begin", err)
+	}
+
+	var record struct {
+		idempotency.Record
+		Headers pq.StringArray `db:"headers"`
+	}
+	selectQuery := `
+        SELECT request_hash, completed, status_code, headers, COALESCE(body, '') AS body
+        FROM idempotency_keys
+        WHERE key = $1
+    `
+	if err := s.db.GetContext(ctx, &record, selectQuery, key); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// 그 사이에 키가 해제됨
+			return s.Begin(ctx, key, owner, requestHash, now, lockedUntil)
+		}
+		return nil, pgerr.Wrap("idempotency.Begin", err)
+	}
+
+	record.Header = decodeHeader(record.Headers)
+	return &record.Record, nil
+}
+
+// Complete implements idempotency.Store.
+// The row is only updated while the owner still holds the key.
+func (s *idempotencyStore) Complete(ctx context.Context, key, owner string, statusCode int, header http.Header, body []byte, expiresAt time.Time) error {
+	if s.db == nil {
+		return errors.NewError(errors.NotConnectedError, "")
+	}
+
+	query := `
+        UPDATE idempotency_keys
+        SET completed = TRUE, status_code = $3, headers = $4, body = $5, expires_at = $6
+        WHERE key = $1 AND owner = $2 AND completed = FALSE
+    `
+	result, err := s.db.ExecContext(ctx, query, key, owner, statusCode, encodeHeader(header), body, expiresAt)
+	if err != nil {
+		return pgerr.Wrap("idempotency.Complete", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return pgerr.Wrap("idempotency.Complete", err)
+	}
+	if rowsAffected == 0 {
+		return idempotency.ErrKeyLost
+	}
+
+	return nil
+}
+
+// Abandon implements idempotency.Store.
+func (s *idempotencyStore) Abandon(ctx context.Context, key, owner string) error {
+	if s.db == nil {
+		return errors.NewError(errors.NotConnectedError, "")
+	}
+
+	query := `
+        DELETE FROM idempotency_keys
+        WHERE key = $1 AND owner = $2 AND completed = FALSE
+    `
+	if _, err := s.db.ExecContext(ctx, query, key, owner); err != nil {
+		return pgerr.Wrap("idempotency.Abandon", err)
+	}
+
+	return nil
+}
+
+// Sweep implements idempotency.Sweeper.
+// Records still being claimed expire too, since their lock has run out.
+func (s *idempotencyStore) Sweep(ctx context.Context, now time.Time) error {
+	if s.db == nil {
+		return errors.NewError(errors.NotConnectedError, "")
+	}
+
+	query := `DELETE FROM idempotency_keys WHERE expires_at <= $1`
+	if _, err := s.db.ExecContext(ctx, query, now); err != nil {
+		return pgerr.Wrap("idempotency.Sweep", err)
+	}
+
+	return nil
+}
+
+// encodeHeader stores every header value as a "Name: value" line, sorted by name.
+func encodeHeader(header http.Header) pq.StringArray {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := pq.StringArray{}
+	for _, name := range names {
+		for _, value := range header[name] {
+			lines = append(lines, name+": "+value)
+		}
+	}
+	return lines
+}
+
+// decodeHeader reverses encodeHeader.
+func decodeHeader(lines []string) http.Header {
+	header := http.Header{}
+	for _, line := range lines {
+		name, value, ok := strings.Cut(line, ": ")
+		if ok {
+			header.Add(name, value)
+		}
+	}
+	return header
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"player_management_system/internal/pkg/idempotency"
+)
+
+func TestIdempotencyStore_BeginClaims(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	store := NewIdempotencyStore(sqlx.NewDb(db, "sqlmock"))
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO idempotency_keys`)).
+		WithArgs("user:a:k1", "r1", "hash", now.Add(time.Minute), now).
+		WillReturnRows(sqlmock.NewRows([]string{"key"}).AddRow("user:a:k1"))
+
+	record, err := store.Begin(context.Background(), "user:a:k1", "r1", "hash", now, now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Nil(t, record)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdempotencyStore_BeginReturnsExisting(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	store := NewIdempotencyStore(sqlx.NewDb(db, "sqlmock"))
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO idempotency_keys`)).
+		WithArgs("user:a:k1", "r1", "hash", now.Add(time.Minute), now).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM idempotency_keys WHERE key = $1`)).
+		WithArgs("user:a:k1").
+		WillReturnRows(sqlmock.NewRows([]string{"request_hash", "completed", "status_code", "headers", "body"}).
+			AddRow("hash", true, 201, `{"Content-Type: application/json","Location: /players/1"}`, []byte(`{"id":"1"}`)))
+
+	record, err := store.Begin(context.Background(), "user:a:k1", "r1", "hash", now, now.Add(time.Minute))
+	assert.NoError(t, err)
+	if assert.NotNil(t, record) {
+		assert.True(t, record.Completed)
+		assert.Equal(t, 201, record.StatusCode)
+		assert.Equal(t, http.Header{"Content-Type": {"application/json"}, "Location": {"/players/1"}}, record.Header)
+		assert.Equal(t, `{"id":"1"}`, string(record.Body))
+	}
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdempotencyStore_CompleteAfterTakeover(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	store := NewIdempotencyStore(sqlx.NewDb(db, "sqlmock"))
+	expiresAt := time.Now().Add(time.Hour)
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE idempotency_keys`)).
+		WithArgs("user:a:k1", "r1", 201, pq.StringArray{"Content-Type: application/json", "Location: /players/1"}, []byte(`{}`), expiresAt).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// 다른 요청이 키를 가져갔으면 갱신되는 행이 없음
+	header := http.Header{"Location": {"/players/1"}, "Content-Type": {"application/json"}}
+	err = store.Complete(context.Background(), "user:a:k1", "r1", 201, header, []byte(`{}`), expiresAt)
+	assert.ErrorIs(t, err, idempotency.ErrKeyLost)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdempotencyStore_Sweep(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	store := NewIdempotencyStore(sqlx.NewDb(db, "sqlmock")).(idempotency.Sweeper)
+	now := time.Now()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM idempotency_keys WHERE expires_at <= $1`)).
+		WithArgs(now).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	assert.NoError(t, store.Sweep(context.Background(), now))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
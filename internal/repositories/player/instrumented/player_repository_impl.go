@@ -0,0 +1,193 @@
+// Package instrumented decorates the player repository with metrics and debug logs, so that the
+// implementations stay free of instrumentation.
+package instrumented
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/metrics"
+	playerRepo "player_management_system/internal/repositories/player"
+)
+
+// repositoryName labels the metrics of the player repository.
+const repositoryName = "player"
+
+type playerRepository struct {
+	next    playerRepo.PlayerRepository
+	metrics *metrics.RepositoryMetrics
+}
+
+// NewPlayerRepository wraps next so that the duration of every call is recorded in m and logged at
+// debug level.
+func NewPlayerRepository(next playerRepo.PlayerRepository, m *metrics.RepositoryMetrics) playerRepo.PlayerRepository {
+	return &playerRepository{next: next, metrics: m}
+}
+
+// observe records a call that started at start and returned *err. It is deferred, so err is read
+// once the call has returned.
+func (r *playerRepository) observe(ctx context.Context, method string, start time.Time, err *error) {
+	r.metrics.Observe(repositoryName, method, start, *err)
+	slog.DebugContext(ctx, "repository call",
+		"repository", repositoryName, "method", method, "duration", time.Since(start), "error", *err)
+}
+
+// CreatePlayer implements playerRepo.PlayerRepository.
+func (r *playerRepository) CreatePlayer(ctx context.Context, p *player.Player) (err error) {
+	defer r.observe(ctx, "CreatePlayer", time.Now(), &err)
+	return r.next.CreatePlayer(ctx, p)
+}
+
+// GetPlayerByID implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetPlayerByID(ctx context.Context, id uuid.UUID) (_ *player.Player, err error) {
+	defer r.observe(ctx, "GetPlayerByID", time.Now(), &err)
+	return r.next.GetPlayerByID(ctx, id)
+}
+
+// UpdatePlayer implements playerRepo.PlayerRepository.
+func (r *playerRepository) UpdatePlayer(ctx context.Context, p *player.Player) (err error) {
+	defer r.observe(ctx, "UpdatePlayer", time.Now(), &err)
+	return r.next.UpdatePlayer(ctx, p)
+}
+
+// UpdateProfileImageURL implements playerRepo.PlayerRepository.
+func (r *playerRepository) UpdateProfileImageURL(ctx context.Context, id uuid.UUID, url string, updatedAt time.Time) (err error) {
+	defer r.observe(ctx, "UpdateProfileImageURL", time.Now(), &err)
+	return r.next.UpdateProfileImageURL(ctx, id, url, updatedAt)
+}
+
+// ReplaceProfileImageURL implements playerRepo.PlayerRepository.
+func (r *playerRepository) ReplaceProfileImageURL(ctx context.Context, id uuid.UUID, from, to string, updatedAt time.Time) (err error) {
+	defer r.observe(ctx, "ReplaceProfileImageURL", time.Now(), &err)
+	return r.next.ReplaceProfileImageURL(ctx, id, from, to, updatedAt)
+}
+
+// DeletePlayer implements playerRepo.PlayerRepository.
+func (r *playerRepository) DeletePlayer(ctx context.Context, id uuid.UUID) (err error) {
+	defer r.observe(ctx, "DeletePlayer", time.Now(), &err)
+	return r.next.DeletePlayer(ctx, id)
+}
+
+// GetPlayers implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetPlayers(ctx context.Context) (_ []*player.Player, err error) {
+	defer r.observe(ctx, "GetPlayers", time.Now(), &err)
+	return r.next.GetPlayers(ctx)
+}
+
+// GetPlayersWithPagination implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetPlayersWithPagination(ctx context.Context, page, pageSize int) (_ []*player.Player, err error) {
+	defer r.observe(ctx, "GetPlayersWithPagination", time.Now(), &err)
+	return r.next.GetPlayersWithPagination(ctx, page, pageSize)
+}
+
+// CreatePlayers implements playerRepo.PlayerRepository.
+func (r *playerRepository) CreatePlayers(ctx context.Context, players []*player.Player, atomic bool) (_ []player.BatchItemStatus, err error) {
+	defer r.observe(ctx, "CreatePlayers", time.Now(), &err)
+	return r.next.CreatePlayers(ctx, players, atomic)
+}
+
+// UpsertPlayersByExternalID implements playerRepo.PlayerRepository.
+func (r *playerRepository) UpsertPlayersByExternalID(ctx context.Context, players []*player.Player, updatableTeams []string) (_ []player.BatchItemStatus, err error) {
+	defer r.observe(ctx, "UpsertPlayersByExternalID", time.Now(), &err)
+	return r.next.UpsertPlayersByExternalID(ctx, players, updatableTeams)
+}
+
+// GetPlayersByExternalIDs implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetPlayersByExternalIDs(ctx context.Context, externalIDs []string) (_ []*player.Player, err error) {
+	defer r.observe(ctx, "GetPlayersByExternalIDs", time.Now(), &err)
+	return r.next.GetPlayersByExternalIDs(ctx, externalIDs)
+}
+
+// GetPlayerByIDWithOptions implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetPlayerByIDWithOptions(ctx context.Context, id uuid.UUID, opts player.ReadOptions) (_ *player.Player, err error) {
+	defer r.observe(ctx, "GetPlayerByIDWithOptions", time.Now(), &err)
+	return r.next.GetPlayerByIDWithOptions(ctx, id, opts)
+}
+
+// GetPlayersWithOptions implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetPlayersWithOptions(ctx context.Context, page, pageSize int, filter player.PlayerFilter, opts player.ReadOptions) (_ []*player.Player, err error) {
+	defer r.observe(ctx, "GetPlayersWithOptions", time.Now(), &err)
+	return r.next.GetPlayersWithOptions(ctx, page, pageSize, filter, opts)
+}
+
+// StreamPlayers implements playerRepo.PlayerRepository. The duration includes the time fn takes.
+func (r *playerRepository) StreamPlayers(ctx context.Context, filter player.PlayerFilter, opts player.ReadOptions, fn func(*player.Player) error) (err error) {
+	defer r.observe(ctx, "StreamPlayers", time.Now(), &err)
+	return r.next.StreamPlayers(ctx, filter, opts, fn)
+}
+
+// MergePlayers implements playerRepo.PlayerRepository.
+func (r *playerRepository) MergePlayers(ctx context.Context, survivorID, mergedID uuid.UUID) (_ string, err error) {
+	defer r.observe(ctx, "MergePlayers", time.Now(), &err)
+	return r.next.MergePlayers(ctx, survivorID, mergedID)
+}
+
+// GetPlayerRedirect implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetPlayerRedirect(ctx context.Context, id uuid.UUID) (_ uuid.UUID, err error) {
+	defer r.observe(ctx, "GetPlayerRedirect", time.Now(), &err)
+	return r.next.GetPlayerRedirect(ctx, id)
+}
+
+// GetPlayerByExternalID implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetPlayerByExternalID(ctx context.Context, namespace, value string, opts player.ReadOptions) (_ *player.Player, err error) {
+	defer r.observe(ctx, "GetPlayerByExternalID", time.Now(), &err)
+	return r.next.GetPlayerByExternalID(ctx, namespace, value, opts)
+}
+
+// CountPlayersBySport implements playerRepo.PlayerRepository.
+func (r *playerRepository) CountPlayersBySport(ctx context.Context) (_ map[string]int, err error) {
+	defer r.observe(ctx, "CountPlayersBySport", time.Now(), &err)
+	return r.next.CountPlayersBySport(ctx)
+}
+
+// GetTeamByName implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetTeamByName(ctx context.Context, name string) (_ *player.Team, err error) {
+	defer r.observe(ctx, "GetTeamByName", time.Now(), &err)
+	return r.next.GetTeamByName(ctx, name)
+}
+
+// GetLatestDescription implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetLatestDescription(ctx context.Context, playerID uuid.UUID) (_ *player.PlayerDescription, err error) {
+	defer r.observe(ctx, "GetLatestDescription", time.Now(), &err)
+	return r.next.GetLatestDescription(ctx, playerID)
+}
+
+// GetRecentMedia implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetRecentMedia(ctx context.Context, playerID uuid.UUID, limit int) (_ []*player.Media, err error) {
+	defer r.observe(ctx, "GetRecentMedia", time.Now(), &err)
+	return r.next.GetRecentMedia(ctx, playerID, limit)
+}
+
+// GetSeasonStats implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetSeasonStats(ctx context.Context, playerID uuid.UUID, season int) (_ *player.SeasonStats, err error) {
+	defer r.observe(ctx, "GetSeasonStats", time.Now(), &err)
+	return r.next.GetSeasonStats(ctx, playerID, season)
+}
+
+// GetCurrentInjury implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetCurrentInjury(ctx context.Context, playerID uuid.UUID) (_ *player.Injury, err error) {
+	defer r.observe(ctx, "GetCurrentInjury", time.Now(), &err)
+	return r.next.GetCurrentInjury(ctx, playerID)
+}
+
+// GetRemoteProfileImageURLs implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetRemoteProfileImageURLs(ctx context.Context, after uuid.UUID, limit int) (_ []*player.Player, err error) {
+	defer r.observe(ctx, "GetRemoteProfileImageURLs", time.Now(), &err)
+	return r.next.GetRemoteProfileImageURLs(ctx, after, limit)
+}
+
+// SaveProfileImageLinkCheck implements playerRepo.PlayerRepository.
+func (r *playerRepository) SaveProfileImageLinkCheck(ctx context.Context, check *player.ProfileImageLinkCheck) (err error) {
+	defer r.observe(ctx, "SaveProfileImageLinkCheck", time.Now(), &err)
+	return r.next.SaveProfileImageLinkCheck(ctx, check)
+}
+
+// GetBrokenProfileImageLinks implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetBrokenProfileImageLinks(ctx context.Context) (_ []*player.ProfileImageLinkCheck, err error) {
+	defer r.observe(ctx, "GetBrokenProfileImageLinks", time.Now(), &err)
+	return r.next.GetBrokenProfileImageLinks(ctx)
+}
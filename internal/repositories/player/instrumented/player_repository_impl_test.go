@@ -0,0 +1,47 @@
+package instrumented
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/metrics"
+	playerRepo "player_management_system/internal/repositories/player"
+)
+
+// stubRepository implements the methods called in the tests; the others panic.
+type stubRepository struct {
+	playerRepo.PlayerRepository
+	players map[uuid.UUID]*player.Player
+}
+
+func (r *stubRepository) GetPlayerByID(_ context.Context, id uuid.UUID) (*player.Player, error) {
+	p, ok := r.players[id]
+	if !ok {
+		return nil, errors.NewError(errors.NotFoundError, "player not found")
+	}
+	return p, nil
+}
+
+func TestPlayerRepository_RecordsCalls(t *testing.T) {
+	known := &player.Player{ID: uuid.New(), Name: "Son Heung-min"}
+	reg := prometheus.NewRegistry()
+	repo := NewPlayerRepository(&stubRepository{players: map[uuid.UUID]*player.Player{known.ID: known}}, metrics.NewRepositoryMetrics(reg))
+
+	// 결과와 오류는 그대로 전달함
+	p, err := repo.GetPlayerByID(context.Background(), known.ID)
+	assert.NoError(t, err)
+	assert.Same(t, known, p)
+
+	_, err = repo.GetPlayerByID(context.Background(), uuid.New())
+	assert.True(t, errors.Is(err, errors.NotFoundError))
+
+	// 성공과 실패가 따로 기록됨
+	assert.Equal(t, 2, testutil.CollectAndCount(reg, "player_management_repository_query_duration_seconds"))
+}
@@ -2,6 +2,7 @@ package player
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"player_management_system/internal/domains/players"
@@ -12,7 +13,29 @@ type PlayerRepository interface {
 	CreatePlayer(ctx context.Context, player *player.Player) error
 	GetPlayerByID(ctx context.Context, id uuid.UUID) (*player.Player, error)
 	UpdatePlayer(ctx context.Context, player *player.Player) error
+	UpdateProfileImageURL(ctx context.Context, id uuid.UUID, url string, updatedAt time.Time) error
+	ReplaceProfileImageURL(ctx context.Context, id uuid.UUID, from, to string, updatedAt time.Time) error
 	DeletePlayer(ctx context.Context, id uuid.UUID) error
 	GetPlayers(ctx context.Context) ([]*player.Player, error)
 	GetPlayersWithPagination(ctx context.Context, page, pageSize int) ([]*player.Player, error)
+	CreatePlayers(ctx context.Context, players []*player.Player, atomic bool) ([]player.BatchItemStatus, error)
+	UpsertPlayersByExternalID(ctx context.Context, players []*player.Player, updatableTeams []string) ([]player.BatchItemStatus, error)
+	GetPlayersByExternalIDs(ctx context.Context, externalIDs []string) ([]*player.Player, error)
+	GetPlayerByIDWithOptions(ctx context.Context, id uuid.UUID, opts player.ReadOptions) (*player.Player, error)
+	GetPlayersWithOptions(ctx context.Context, page, pageSize int, filter player.PlayerFilter, opts player.ReadOptions) ([]*player.Player, error)
+	StreamPlayers(ctx context.Context, filter player.PlayerFilter, opts player.ReadOptions, fn func(*player.Player) error) error
+	MergePlayers(ctx context.Context, survivorID, mergedID uuid.UUID) (string, error)
+	GetPlayerRedirect(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	GetPlayerByExternalID(ctx context.Context, namespace, value string, opts player.ReadOptions) (*player.Player, error)
+	CountPlayersBySport(ctx context.Context) (map[string]int, error)
+
+	GetTeamByName(ctx context.Context, name string) (*player.Team, error)
+	GetLatestDescription(ctx context.Context, playerID uuid.UUID) (*player.PlayerDescription, error)
+	GetRecentMedia(ctx context.Context, playerID uuid.UUID, limit int) ([]*player.Media, error)
+	GetSeasonStats(ctx context.Context, playerID uuid.UUID, season int) (*player.SeasonStats, error)
+	GetCurrentInjury(ctx context.Context, playerID uuid.UUID) (*player.Injury, error)
+
+	GetRemoteProfileImageURLs(ctx context.Context, after uuid.UUID, limit int) ([]*player.Player, error)
+	SaveProfileImageLinkCheck(ctx context.Context, check *player.ProfileImageLinkCheck) error
+	GetBrokenProfileImageLinks(ctx context.Context) ([]*player.ProfileImageLinkCheck, error)
 }
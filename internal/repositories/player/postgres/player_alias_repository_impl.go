@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/pgerr"
+)
+
+// replaceAliases replaces the player's aliases.
+func replaceAliases(ctx context.Context, tx *sqlx.Tx, playerID uuid.UUID, aliases []*player.Alias) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM player_aliases WHERE player_id = $1`, playerID); err != nil {
+		return pgerr.Wrap("player.replaceAliases", err)
+	}
+
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	const columns = 6
+	rows := make([]string, 0, len(aliases))
+	args := make([]interface{}, 0, len(aliases)*columns)
+	for i, a := range aliases {
+		placeholders := make([]string, columns)
+		for j := range placeholders {
+			placeholders[j] = fmt.Sprintf("$%d", i*columns+j+1)
+		}
+		rows = append(rows, "("+strings.Join(placeholders, ", ")+")")
+		args = append(args, a.ID, playerID, a.Name, a.Type, a.Locale, a.Primary)
+	}
+	query := `
+        INSERT INTO player_aliases (id, player_id, name, type, locale, is_primary)
+        VALUES ` + strings.Join(rows, ", ")
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return pgerr.Wrap("player.replaceAliases", err)
+	}
+
+	for _, a := range aliases {
+		a.PlayerID = playerID
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	playerDom "player_management_system/internal/domains/players"
+)
+
+func TestCreatePlayer_WithAliases(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	alias := &playerDom.Alias{ID: uuid.New(), Name: "Kim Do-yeong", Type: playerDom.AliasTypeRomanized, Locale: playerDom.LocaleKoreanLatin, Primary: true}
+	p := &playerDom.Player{
+		ID:        uuid.New(),
+		Name:      "김도영",
+		Sport:     "야구",
+		Team:      "기아",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Aliases:   []*playerDom.Alias{alias},
+	}
+
+	// 외부 ID가 nil이면 별칭만 교체
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO players`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM player_aliases WHERE player_id = $1`)).
+		WithArgs(p.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO player_aliases (id, player_id, name, type, locale, is_primary) VALUES ($1, $2, $3, $4, $5, $6)`)).
+		WithArgs(alias.ID, p.ID, "Kim Do-yeong", playerDom.AliasTypeRomanized, playerDom.LocaleKoreanLatin, true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.CreatePlayer(context.Background(), p)
+	assert.NoError(t, err)
+	assert.Equal(t, p.ID, alias.PlayerID)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestStreamPlayers_ExpandAliases(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+	repo := NewPlayerRepository(sqlxDB)
+
+	firstID, secondID := uuid.New(), uuid.New()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DECLARE player_stream NO SCROLL CURSOR FOR SELECT id, name FROM players ORDER BY created_at, id`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`FETCH FORWARD 500 FROM player_stream`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).
+			AddRow(firstID, "김도영").
+			AddRow(secondID, "양현종"))
+
+	// 청크마다 한 번의 쿼리로 별칭을 불러옴
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM player_aliases WHERE player_id IN ($1, $2)`)).
+		WithArgs(firstID, secondID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "player_id", "name", "type", "locale", "is_primary"}).
+			AddRow(uuid.New(), firstID, "Kim Do-yeong", playerDom.AliasTypeRomanized, playerDom.LocaleKoreanLatin, false))
+	mock.ExpectCommit()
+
+	var players []*playerDom.Player
+	opts := playerDom.ReadOptions{Fields: []string{"name"}, Expand: []string{playerDom.ExpandAliases}}
+	err = repo.StreamPlayers(context.Background(), playerDom.PlayerFilter{}, opts, func(p *playerDom.Player) error {
+		players = append(players, p)
+		return nil
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, players, 2) {
+		assert.Equal(t, "Kim Do-yeong", players[0].Aliases[0].Name)
+		assert.Empty(t, players[1].Aliases)
+	}
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
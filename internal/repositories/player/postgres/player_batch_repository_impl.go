@@ -0,0 +1,303 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/pgerr"
+)
+
+// batchInsertColumns is the number of columns written per player in a multi-row insert.
+const batchInsertColumns = 8
+
+// CreatePlayers implements playerRepo.PlayerRepository.
+// The players and their external IDs in the default namespace are written with multi-row inserts
+// inside a transaction. Players whose external ID is already linked to a player are reported as
+// conflicts and not created; in atomic mode any conflict rolls the whole batch back.
+func (r *playerRepository) CreatePlayers(ctx context.Context, players []*player.Player, atomic bool) ([]player.BatchItemStatus, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+	if len(players) == 0 {
+		return []player.BatchItemStatus{}, nil
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, pgerr.Wrap("player.CreatePlayers", err)
+	}
+	defer tx.Rollback()
+
+	if err := insertPlayers(ctx, tx, players); err != nil {
+		return nil, pgerr.Wrap("player.CreatePlayers", err)
+	}
+
+	linked, err := insertDefaultExternalIDs(ctx, tx, players)
+	if err != nil {
+		return nil, pgerr.Wrap("player.CreatePlayers", err)
+	}
+
+	statuses := make([]player.BatchItemStatus, len(players))
+	var conflicts []uuid.UUID
+	for i, p := range players {
+		if p.ExternalID == "" || linked[p.ID] {
+			statuses[i] = player.BatchItemCreated
+		} else {
+			statuses[i] = player.BatchItemConflict
+			conflicts = append(conflicts, p.ID)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		if atomic {
+			return statuses, errors.NewError(errors.AlreadyExistsError, "batch contains players that already exist")
+		}
+
+		// 외부 식별자를 연결하지 못한 선수는 만들지 않음
+		query, args, err := sqlx.In(`DELETE FROM players WHERE id IN (?)`, conflicts)
+		if err != nil {
+			return nil, errors.Wrap(errors.InternalError, "player.CreatePlayers", err)
+		}
+		if _, err := tx.ExecContext(ctx, tx.Rebind(query), args...); err != nil {
+			return nil, pgerr.Wrap("player.CreatePlayers", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, pgerr.Wrap("player.CreatePlayers", err)
+	}
+
+	return statuses, nil
+}
+
+// UpsertPlayersByExternalID implements playerRepo.PlayerRepository.
+// Players are matched on their external ID in the default namespace. Matched players are updated in
+// place and keep their stored ID; the others are created with the ID linked to them. Unless
+// updatableTeams is nil, the matched players are locked and must belong to one of those teams, so
+// that their team cannot change between the check and the update.
+func (r *playerRepository) UpsertPlayersByExternalID(ctx context.Context, players []*player.Player, updatableTeams []string) ([]player.BatchItemStatus, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+	if len(players) == 0 {
+		return []player.BatchItemStatus{}, nil
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, pgerr.Wrap("player.UpsertPlayersByExternalID", err)
+	}
+	defer tx.Rollback()
+
+	externalIDs := make([]string, 0, len(players))
+	for _, p := range players {
+		externalIDs = append(externalIDs, p.ExternalID)
+	}
+
+	query, args, err := sqlx.In(`
+        SELECT player_external_ids.player_id, player_external_ids.value, players.team
+        FROM player_external_ids
+        JOIN players ON players.id = player_external_ids.player_id
+        WHERE player_external_ids.namespace = ? AND player_external_ids.value IN (?)
+        FOR UPDATE
+    `, player.NamespaceDefault, externalIDs)
+	if err != nil {
+		return nil, errors.Wrap(errors.InternalError, "player.UpsertPlayersByExternalID", err)
+	}
+	var matches []struct {
+		PlayerID uuid.UUID `db:"player_id"`
+		Value    string    `db:"value"`
+		Team     string    `db:"team"`
+	}
+	if err := tx.SelectContext(ctx, &matches, tx.Rebind(query), args...); err != nil {
+		return nil, pgerr.Wrap("player.UpsertPlayersByExternalID", err)
+	}
+
+	existing := make(map[string]uuid.UUID, len(matches))
+	for _, m := range matches {
+		if updatableTeams != nil && !slices.Contains(updatableTeams, m.Team) {
+			return nil, errors.NewErrorWithArgs(errors.ForbiddenError, "not allowed to update players of team %s", m.Team)
+		}
+		existing[m.Value] = m.PlayerID
+	}
+
+	statuses := make([]player.BatchItemStatus, len(players))
+	var updates, creates []*player.Player
+	for i, p := range players {
+		if id, ok := existing[p.ExternalID]; ok {
+			p.ID = id
+			statuses[i] = player.BatchItemUpdated
+			updates = append(updates, p)
+		} else {
+			statuses[i] = player.BatchItemCreated
+			creates = append(creates, p)
+		}
+	}
+
+	if len(updates) > 0 {
+		if err := updatePlayers(ctx, tx, updates); err != nil {
+			return nil, pgerr.Wrap("player.UpsertPlayersByExternalID", err)
+		}
+	}
+	if len(creates) > 0 {
+		if err := insertPlayers(ctx, tx, creates); err != nil {
+			return nil, pgerr.Wrap("player.UpsertPlayersByExternalID", err)
+		}
+		linked, err := insertDefaultExternalIDs(ctx, tx, creates)
+		if err != nil {
+			return nil, pgerr.Wrap("player.UpsertPlayersByExternalID", err)
+		}
+		if len(linked) != len(creates) {
+			// 조회 이후 다른 요청이 같은 외부 식별자를 연결함
+			return nil, errors.NewError(errors.AbortedError, "external IDs were linked concurrently")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, pgerr.Wrap("player.UpsertPlayersByExternalID", err)
+	}
+
+	return statuses, nil
+}
+
+// GetPlayersByExternalIDs implements playerRepo.PlayerRepository.
+// The external IDs are those in the default namespace.
+func (r *playerRepository) GetPlayersByExternalIDs(ctx context.Context, externalIDs []string) ([]*player.Player, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	players := []*player.Player{}
+	if len(externalIDs) == 0 {
+		return players, nil
+	}
+
+	query, args, err := sqlx.In(`
+        SELECT players.id, name, sport, team, COALESCE(profile_image_url, '') AS profile_image_url,
+               player_external_ids.value AS external_id, created_at, updated_at
+        FROM players
+        JOIN player_external_ids ON player_external_ids.player_id = players.id
+        WHERE player_external_ids.namespace = ? AND player_external_ids.value IN (?)
+    `, player.NamespaceDefault, externalIDs)
+	if err != nil {
+		return nil, errors.Wrap(errors.InternalError, "player.GetPlayersByExternalIDs", err)
+	}
+
+	err = r.db.SelectContext(ctx, &players, r.db.Rebind(query), args...)
+	if err != nil {
+		return nil, pgerr.Wrap("player.GetPlayersByExternalIDs", err)
+	}
+
+	return players, nil
+}
+
+// insertPlayers writes the players with a single multi-row insert.
+func insertPlayers(ctx context.Context, tx *sqlx.Tx, players []*player.Player) error {
+	rows := make([]string, 0, len(players))
+	args := make([]interface{}, 0, len(players)*batchInsertColumns)
+	for i, p := range players {
+		rows = append(rows, placeholderRow(i*batchInsertColumns, batchInsertColumns, nil))
+		args = append(args, p.ID, p.Name, p.Sport, p.Team, p.ProfileImageURL, p.BirthDate, p.CreatedAt, p.UpdatedAt)
+	}
+
+	query := `
+        INSERT INTO players (id, name, sport, team, profile_image_url, birth_date, created_at, updated_at)
+        VALUES ` + strings.Join(rows, ", ")
+	_, err := tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+// updateColumnTypes are the casts of the columns of a multi-row update, whose VALUES list has no
+// table to take the types from.
+var updateColumnTypes = []string{"uuid", "text", "text", "text", "text", "date", "timestamptz"}
+
+// updatePlayers updates the players with a single multi-row update. Birth dates are only overwritten
+// when set.
+func updatePlayers(ctx context.Context, tx *sqlx.Tx, players []*player.Player) error {
+	columns := len(updateColumnTypes)
+	rows := make([]string, 0, len(players))
+	args := make([]interface{}, 0, len(players)*columns)
+	for i, p := range players {
+		rows = append(rows, placeholderRow(i*columns, columns, updateColumnTypes))
+		args = append(args, p.ID, p.Name, p.Sport, p.Team, p.ProfileImageURL, p.BirthDate, p.UpdatedAt)
+	}
+
+	query := `
+        UPDATE players
+        SET name = v.name, sport = v.sport, team = v.team, profile_image_url = v.profile_image_url,
+            birth_date = COALESCE(v.birth_date, players.birth_date), updated_at = v.updated_at
+        FROM (VALUES ` + strings.Join(rows, ", ") + `) AS v (id, name, sport, team, profile_image_url, birth_date, updated_at)
+        WHERE players.id = v.id
+        RETURNING players.id, players.created_at
+    `
+	var updated []struct {
+		ID        uuid.UUID `db:"id"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	if err := tx.SelectContext(ctx, &updated, query, args...); err != nil {
+		return err
+	}
+
+	createdAt := make(map[uuid.UUID]time.Time, len(updated))
+	for _, u := range updated {
+		createdAt[u.ID] = u.CreatedAt
+	}
+	for _, p := range players {
+		p.CreatedAt = createdAt[p.ID]
+	}
+	return nil
+}
+
+// insertDefaultExternalIDs links the players to their external IDs in the default namespace and
+// returns the players that were linked. IDs already linked to another player are skipped.
+func insertDefaultExternalIDs(ctx context.Context, tx *sqlx.Tx, players []*player.Player) (map[uuid.UUID]bool, error) {
+	var rows []string
+	var args []interface{}
+	for _, p := range players {
+		if p.ExternalID == "" {
+			continue
+		}
+		rows = append(rows, placeholderRow(len(args), 3, nil))
+		args = append(args, p.ID, player.NamespaceDefault, p.ExternalID)
+	}
+
+	linked := make(map[uuid.UUID]bool, len(rows))
+	if len(rows) == 0 {
+		return linked, nil
+	}
+
+	query := `
+        INSERT INTO player_external_ids (player_id, namespace, value)
+        VALUES ` + strings.Join(rows, ", ") + `
+        ON CONFLICT DO NOTHING
+        RETURNING player_id
+    `
+	var inserted []uuid.UUID
+	if err := tx.SelectContext(ctx, &inserted, query, args...); err != nil {
+		return nil, err
+	}
+	for _, id := range inserted {
+		linked[id] = true
+	}
+	return linked, nil
+}
+
+// placeholderRow builds a row of n placeholders numbered from offset+1, cast to types when given.
+func placeholderRow(offset, n int, types []string) string {
+	placeholders := make([]string, n)
+	for j := range placeholders {
+		placeholders[j] = fmt.Sprintf("$%d", offset+j+1)
+		if types != nil {
+			placeholders[j] += "::" + types[j]
+		}
+	}
+	return "(" + strings.Join(placeholders, ", ") + ")"
+}
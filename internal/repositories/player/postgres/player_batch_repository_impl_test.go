@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	playerDom "player_management_system/internal/domains/players"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+func newBatchPlayers() []*playerDom.Player {
+	return []*playerDom.Player{
+		{ID: uuid.New(), Name: "Player 1", Sport: "Football", Team: "Team A", ExternalID: "ext-1", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: uuid.New(), Name: "Player 2", Sport: "Football", Team: "Team A", ExternalID: "ext-2", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	}
+}
+
+func TestCreatePlayers_Partial(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+	repo := NewPlayerRepository(sqlxDB)
+
+	players := newBatchPlayers()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO players (id, name, sport, team, profile_image_url, birth_date, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8), ($9, $10, $11, $12, $13, $14, $15, $16)`)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO player_external_ids (player_id, namespace, value) VALUES ($1, $2, $3), ($4, $5, $6) ON CONFLICT DO NOTHING RETURNING player_id`)).
+		WithArgs(players[0].ID, "default", "ext-1", players[1].ID, "default", "ext-2").
+		WillReturnRows(sqlmock.NewRows([]string{"player_id"}).AddRow(players[1].ID))
+	// 외부 식별자가 이미 연결된 선수는 만들지 않음
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM players WHERE id IN ($1)`)).
+		WithArgs(players[0].ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	statuses, err := repo.CreatePlayers(context.Background(), players, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []playerDom.BatchItemStatus{playerDom.BatchItemConflict, playerDom.BatchItemCreated}, statuses)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestCreatePlayers_AtomicConflictRollsBack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	players := newBatchPlayers()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO players`)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO player_external_ids`)).
+		WillReturnRows(sqlmock.NewRows([]string{"player_id"}).AddRow(players[0].ID))
+	mock.ExpectRollback()
+
+	statuses, err := repo.CreatePlayers(context.Background(), players, true)
+	assert.Equal(t, []playerDom.BatchItemStatus{playerDom.BatchItemCreated, playerDom.BatchItemConflict}, statuses)
+
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.AlreadyExistsError, customErr.Code)
+	}
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestCreatePlayers_WithoutExternalIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	players := newBatchPlayers()
+	for _, p := range players {
+		p.ExternalID = ""
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO players`)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	statuses, err := repo.CreatePlayers(context.Background(), players, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []playerDom.BatchItemStatus{playerDom.BatchItemCreated, playerDom.BatchItemCreated}, statuses)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestUpsertPlayersByExternalID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+	repo := NewPlayerRepository(sqlxDB)
+
+	players := newBatchPlayers()
+	newID := players[1].ID
+	existingID := uuid.New()
+	createdAt := time.Now().Add(-time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT player_external_ids.player_id, player_external_ids.value, players.team FROM player_external_ids JOIN players ON players.id = player_external_ids.player_id WHERE player_external_ids.namespace = $1 AND player_external_ids.value IN ($2, $3) FOR UPDATE`)).
+		WithArgs("default", "ext-1", "ext-2").
+		WillReturnRows(sqlmock.NewRows([]string{"player_id", "value", "team"}).AddRow(existingID, "ext-1", "Team A"))
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE players SET name = v.name, sport = v.sport, team = v.team, profile_image_url = v.profile_image_url, birth_date = COALESCE(v.birth_date, players.birth_date), updated_at = v.updated_at FROM (VALUES ($1::uuid, $2::text, $3::text, $4::text, $5::text, $6::date, $7::timestamptz)) AS v (id, name, sport, team, profile_image_url, birth_date, updated_at) WHERE players.id = v.id RETURNING players.id, players.created_at`)).
+		WithArgs(existingID, "Player 1", "Football", "Team A", "", nil, players[0].UpdatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(existingID, createdAt))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO players (id, name, sport, team, profile_image_url, birth_date, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO player_external_ids (player_id, namespace, value) VALUES ($1, $2, $3)`)).
+		WithArgs(newID, "default", "ext-2").
+		WillReturnRows(sqlmock.NewRows([]string{"player_id"}).AddRow(newID))
+	mock.ExpectCommit()
+
+	statuses, err := repo.UpsertPlayersByExternalID(context.Background(), players, []string{"Team A"})
+	assert.NoError(t, err)
+	assert.Equal(t, []playerDom.BatchItemStatus{playerDom.BatchItemUpdated, playerDom.BatchItemCreated}, statuses)
+	assert.Equal(t, existingID, players[0].ID)
+	assert.Equal(t, createdAt, players[0].CreatedAt)
+	assert.Equal(t, newID, players[1].ID)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestUpsertPlayersByExternalID_LinkedConcurrently(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+	repo := NewPlayerRepository(sqlxDB)
+
+	players := newBatchPlayers()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM player_external_ids JOIN players`)).
+		WillReturnRows(sqlmock.NewRows([]string{"player_id", "value", "team"}))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO players`)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	// 조회 이후 다른 요청이 ext-1을 연결함
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO player_external_ids`)).
+		WillReturnRows(sqlmock.NewRows([]string{"player_id"}).AddRow(players[1].ID))
+	mock.ExpectRollback()
+
+	_, err = repo.UpsertPlayersByExternalID(context.Background(), players, nil)
+
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.AbortedError, customErr.Code)
+	}
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestUpsertPlayersByExternalID_LockedPlayerOfAnotherTeam(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+	repo := NewPlayerRepository(sqlxDB)
+
+	players := newBatchPlayers()
+
+	mock.ExpectBegin()
+	// 조회 이전에 다른 요청이 ext-1 선수를 Team B로 옮김
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM player_external_ids JOIN players`)).
+		WillReturnRows(sqlmock.NewRows([]string{"player_id", "value", "team"}).AddRow(uuid.New(), "ext-1", "Team B"))
+	mock.ExpectRollback()
+
+	_, err = repo.UpsertPlayersByExternalID(context.Background(), players, []string{"Team A"})
+
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.ForbiddenError, customErr.Code)
+	}
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetPlayersByExternalIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+	repo := NewPlayerRepository(sqlxDB)
+
+	rows := sqlmock.NewRows([]string{"id", "name", "sport", "team", "profile_image_url", "external_id", "created_at", "updated_at"}).
+		AddRow(uuid.New(), "Player 1", "Football", "Team A", "", "ext-1", time.Now(), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`JOIN player_external_ids ON player_external_ids.player_id = players.id WHERE player_external_ids.namespace = $1 AND player_external_ids.value IN ($2, $3)`)).
+		WithArgs("default", "ext-1", "ext-2").
+		WillReturnRows(rows)
+
+	players, err := repo.GetPlayersByExternalIDs(context.Background(), []string{"ext-1", "ext-2"})
+	assert.NoError(t, err)
+	assert.Len(t, players, 1)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/pgerr"
+)
+
+// GetPlayerByExternalID implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetPlayerByExternalID(ctx context.Context, namespace, value string, opts player.ReadOptions) (*player.Player, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	var p player.Player
+	query := fmt.Sprintf(`
+        SELECT %s
+        FROM players
+        WHERE id = (SELECT player_id FROM player_external_ids WHERE namespace = $1 AND value = $2)
+    `, selectColumns(opts))
+
+	err := r.db.GetContext(ctx, &p, query, namespace, value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrapf(errors.NotFoundError, "player.GetPlayerByExternalID", err, "player not found")
+		}
+		return nil, pgerr.Wrap("player.GetPlayerByExternalID", err)
+	}
+
+	if err := r.loadRelations(ctx, []*player.Player{&p}, opts); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// replaceExternalIDs replaces the player's external identifiers.
+// An identifier linked to another player is reported as AlreadyExists.
+func replaceExternalIDs(ctx context.Context, tx *sqlx.Tx, playerID uuid.UUID, ids []*player.ExternalIdentifier) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM player_external_ids WHERE player_id = $1`, playerID); err != nil {
+		return pgerr.Wrap("player.replaceExternalIDs", err)
+	}
+
+	if len(ids) > 0 {
+		rows := make([]string, 0, len(ids))
+		args := make([]interface{}, 0, len(ids)*3)
+		for i, id := range ids {
+			rows = append(rows, fmt.Sprintf("($%d, $%d, $%d)", i*3+1, i*3+2, i*3+3))
+			args = append(args, playerID, id.Namespace, id.Value)
+		}
+		query := `
+            INSERT INTO player_external_ids (player_id, namespace, value)
+            VALUES ` + strings.Join(rows, ", ")
+
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			e := pgerr.Wrap("player.replaceExternalIDs", err)
+			if e.Code == errors.AlreadyExistsError {
+				e.Message = "external ID is already linked to another player"
+			}
+			return e
+		}
+	}
+
+	for _, id := range ids {
+		id.PlayerID = playerID
+	}
+	return nil
+}
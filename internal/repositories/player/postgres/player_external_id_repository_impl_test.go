@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	playerDom "player_management_system/internal/domains/players"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+func TestGetPlayerByExternalID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+	repo := NewPlayerRepository(sqlxDB)
+
+	playerID := uuid.New()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name FROM players WHERE id = (SELECT player_id FROM player_external_ids WHERE namespace = $1 AND value = $2)`)).
+		WithArgs("wikidata", "Q42").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(playerID, "Test Player"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT player_id, namespace, value FROM player_external_ids WHERE player_id IN ($1) ORDER BY namespace`)).
+		WithArgs(playerID).
+		WillReturnRows(sqlmock.NewRows([]string{"player_id", "namespace", "value"}).
+			AddRow(playerID, "league", "KBO-1001").
+			AddRow(playerID, "wikidata", "Q42"))
+
+	opts := playerDom.ReadOptions{Fields: []string{"name"}, Expand: []string{playerDom.ExpandExternalIDs}}
+	p, err := repo.GetPlayerByExternalID(context.Background(), "wikidata", "Q42", opts)
+	assert.NoError(t, err)
+	assert.Equal(t, playerID, p.ID)
+	assert.Len(t, p.ExternalIDs, 2)
+	assert.Equal(t, "KBO-1001", p.ExternalIDs[0].Value)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetPlayerByExternalID_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+	repo := NewPlayerRepository(sqlxDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM players WHERE id = (SELECT player_id FROM player_external_ids`)).
+		WithArgs("league", "unknown").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetPlayerByExternalID(context.Background(), "league", "unknown", playerDom.ReadOptions{})
+	assert.Equal(t, customErrors.NotFoundError, err.(*customErrors.Error).Code)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestCreatePlayer_WithExternalIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	p := &playerDom.Player{
+		ID:        uuid.New(),
+		Name:      "Test Player",
+		Sport:     "Baseball",
+		Team:      "Test Team",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		ExternalIDs: []*playerDom.ExternalIdentifier{
+			{Namespace: "league", Value: "KBO-1001"},
+			{Namespace: "wikidata", Value: "Q42"},
+		},
+	}
+
+	// 선수 생성과 외부 ID 교체는 하나의 트랜잭션에서 실행
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO players`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM player_external_ids WHERE player_id = $1`)).
+		WithArgs(p.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO player_external_ids (player_id, namespace, value) VALUES ($1, $2, $3), ($4, $5, $6)`)).
+		WithArgs(p.ID, "league", "KBO-1001", p.ID, "wikidata", "Q42").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err = repo.CreatePlayer(context.Background(), p)
+	assert.NoError(t, err)
+	assert.Equal(t, p.ID, p.ExternalIDs[1].PlayerID)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestUpdatePlayer_ExternalIDConflict(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	p := &playerDom.Player{
+		ID:          uuid.New(),
+		Name:        "Test Player",
+		Sport:       "Baseball",
+		Team:        "Test Team",
+		UpdatedAt:   time.Now(),
+		ExternalIDs: []*playerDom.ExternalIdentifier{{Namespace: "league", Value: "KBO-1001"}},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE players SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM player_external_ids WHERE player_id = $1`)).
+		WithArgs(p.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO player_external_ids`)).
+		WillReturnError(&pq.Error{Code: "23505"})
+	mock.ExpectRollback()
+
+	// 다른 선수에게 연결된 외부 ID는 충돌로 처리
+	err = repo.UpdatePlayer(context.Background(), p)
+	assert.Equal(t, customErrors.AlreadyExistsError, err.(*customErrors.Error).Code)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/pgerr"
+)
+
+// MergePlayers implements playerRepo.PlayerRepository.
+// Within one transaction the descriptions, media, injuries, season stats, external IDs and aliases of
+// the merged player are moved to the survivor, fields the survivor lacks are copied over, the merged
+// player is deleted and a redirect from its ID to the survivor is recorded. Season stats the survivor
+// already has win. Players with external IDs in the same namespace are not merged, since one of the
+// IDs would be lost. It returns the merged player's profile image URL, as locked, when the survivor
+// kept its own instead, so that the caller can delete the image no player links to anymore.
+func (r *playerRepository) MergePlayers(ctx context.Context, survivorID, mergedID uuid.UUID) (string, error) {
+	if r.db == nil {
+		return "", errors.NewError(errors.NotConnectedError, "")
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", pgerr.Wrap("player.MergePlayers", err)
+	}
+	defer tx.Rollback()
+
+	var players []*player.Player
+	lockQuery := `
+        SELECT id, COALESCE(profile_image_url, '') AS profile_image_url, birth_date
+        FROM players
+        WHERE id IN ($1, $2)
+        FOR UPDATE
+    `
+	if err := tx.SelectContext(ctx, &players, lockQuery, survivorID, mergedID); err != nil {
+		return "", pgerr.Wrap("player.MergePlayers", err)
+	}
+
+	var survivor, merged *player.Player
+	for _, p := range players {
+		switch p.ID {
+		case survivorID:
+			survivor = p
+		case mergedID:
+			merged = p
+		}
+	}
+	if survivor == nil || merged == nil {
+		return "", errors.NewErrorWithArgs(errors.NotFoundError, "player not found")
+	}
+
+	var conflicts []string
+	conflictQuery := `
+        SELECT merged.namespace
+        FROM player_external_ids merged
+        JOIN player_external_ids survivor ON survivor.namespace = merged.namespace AND survivor.player_id = $1
+        WHERE merged.player_id = $2
+        ORDER BY merged.namespace
+    `
+	if err := tx.SelectContext(ctx, &conflicts, conflictQuery, survivorID, mergedID); err != nil {
+		return "", pgerr.Wrap("player.MergePlayers", err)
+	}
+	if len(conflicts) > 0 {
+		return "", errors.NewErrorWithKey(errors.FailedPreconditionError, errors.MsgConflictingExternalIDs, strings.Join(conflicts, ", "))
+	}
+
+	statements := []string{
+		`UPDATE player_descriptions SET player_id = $1 WHERE player_id = $2`,
+		`UPDATE media SET player_id = $1 WHERE player_id = $2`,
+		`UPDATE player_injuries SET player_id = $1 WHERE player_id = $2`,
+		`UPDATE player_season_stats SET player_id = $1
+         WHERE player_id = $2
+           AND season NOT IN (SELECT season FROM player_season_stats WHERE player_id = $1)`,
+		`DELETE FROM player_season_stats WHERE player_id = $2`,
+		`UPDATE player_external_ids SET player_id = $1 WHERE player_id = $2`,
+		`UPDATE player_aliases
+         SET player_id = $1,
+             is_primary = is_primary AND NOT EXISTS (
+                 SELECT 1 FROM player_aliases survivor
+                 WHERE survivor.player_id = $1 AND survivor.locale = player_aliases.locale AND survivor.is_primary)
+         WHERE player_id = $2`,
+		`UPDATE player_redirects SET to_id = $1 WHERE to_id = $2`,
+		`DELETE FROM players WHERE id = $2`,
+	}
+	for _, query := range statements {
+		if _, err := tx.ExecContext(ctx, query, survivorID, mergedID); err != nil {
+			return "", pgerr.Wrap("player.MergePlayers", err)
+		}
+	}
+
+	now := time.Now()
+	updateQuery := `
+        UPDATE players
+        SET profile_image_url = COALESCE(NULLIF(profile_image_url, ''), NULLIF($2, '')),
+            birth_date = COALESCE(birth_date, $3),
+            updated_at = $4
+        WHERE id = $1
+    `
+	if _, err := tx.ExecContext(ctx, updateQuery, survivorID, merged.ProfileImageURL, merged.BirthDate, now); err != nil {
+		return "", pgerr.Wrap("player.MergePlayers", err)
+	}
+
+	redirectQuery := `
+        INSERT INTO player_redirects (from_id, to_id, merged_at)
+        VALUES ($1, $2, $3)
+    `
+	if _, err := tx.ExecContext(ctx, redirectQuery, mergedID, survivorID, now); err != nil {
+		return "", pgerr.Wrap("player.MergePlayers", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", pgerr.Wrap("player.MergePlayers", err)
+	}
+
+	// 생존 선수가 자신의 이미지를 유지했으면 병합된 선수의 이미지는 더 이상 쓰이지 않음
+	if survivor.ProfileImageURL != "" && merged.ProfileImageURL != survivor.ProfileImageURL {
+		return merged.ProfileImageURL, nil
+	}
+	return "", nil
+}
+
+// GetPlayerRedirect implements playerRepo.PlayerRepository.
+// It returns the ID of the player a merged player was folded into.
+func (r *playerRepository) GetPlayerRedirect(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	if r.db == nil {
+		return uuid.Nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	var target uuid.UUID
+	query := `
+        SELECT to_id
+        FROM player_redirects
+        WHERE from_id = $1
+    `
+
+	err := r.db.GetContext(ctx, &target, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, errors.Wrapf(errors.NotFoundError, "player.GetPlayerRedirect", err, "player not found")
+		}
+		return uuid.Nil, pgerr.Wrap("player.GetPlayerRedirect", err)
+	}
+
+	return target, nil
+}
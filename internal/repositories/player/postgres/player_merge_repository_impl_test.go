@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+func TestMergePlayers(t *testing.T) {
+	survivorID, mergedID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name          string
+		survivorURL   string
+		mergedURL     string
+		wantDiscarded string
+	}{
+		{"survivor takes over the image", "", "http://example.com/image.jpg", ""},
+		{"survivor keeps its image", "/images/survivor.jpg", "/images/merged.jpg", "/images/merged.jpg"},
+		{"same image", "/images/shared.jpg", "/images/shared.jpg", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			sqlxDB := sqlx.NewDb(db, "sqlmock")
+			repo := NewPlayerRepository(sqlxDB)
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`FROM players WHERE id IN ($1, $2) FOR UPDATE`)).
+				WithArgs(survivorID, mergedID).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "profile_image_url", "birth_date"}).
+					AddRow(survivorID, tt.survivorURL, nil).
+					AddRow(mergedID, tt.mergedURL, nil))
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT merged.namespace FROM player_external_ids merged`)).
+				WithArgs(survivorID, mergedID).
+				WillReturnRows(sqlmock.NewRows([]string{"namespace"}))
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE player_descriptions SET player_id = $1 WHERE player_id = $2`)).
+				WithArgs(survivorID, mergedID).WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE media SET player_id = $1 WHERE player_id = $2`)).
+				WithArgs(survivorID, mergedID).WillReturnResult(sqlmock.NewResult(0, 3))
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE player_injuries`)).
+				WithArgs(survivorID, mergedID).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE player_season_stats`)).
+				WithArgs(survivorID, mergedID).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM player_season_stats`)).
+				WithArgs(survivorID, mergedID).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE player_external_ids`)).
+				WithArgs(survivorID, mergedID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE player_aliases`)).
+				WithArgs(survivorID, mergedID).WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE player_redirects SET to_id = $1 WHERE to_id = $2`)).
+				WithArgs(survivorID, mergedID).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM players WHERE id = $2`)).
+				WithArgs(survivorID, mergedID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE players SET profile_image_url`)).
+				WithArgs(survivorID, tt.mergedURL, nil, sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO player_redirects (from_id, to_id, merged_at)`)).
+				WithArgs(mergedID, survivorID, sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			discarded, err := repo.MergePlayers(context.Background(), survivorID, mergedID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantDiscarded, discarded)
+
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMergePlayers_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	survivorID, mergedID := uuid.New(), uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM players WHERE id IN ($1, $2) FOR UPDATE`)).
+		WithArgs(survivorID, mergedID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "profile_image_url", "birth_date"}).
+			AddRow(survivorID, "", nil))
+	mock.ExpectRollback()
+
+	_, err = repo.MergePlayers(context.Background(), survivorID, mergedID)
+
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.NotFoundError, customErr.Code)
+	}
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestMergePlayers_ConflictingExternalIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	survivorID, mergedID := uuid.New(), uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM players WHERE id IN ($1, $2) FOR UPDATE`)).
+		WithArgs(survivorID, mergedID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "profile_image_url", "birth_date"}).
+			AddRow(survivorID, "", nil).
+			AddRow(mergedID, "", nil))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT merged.namespace FROM player_external_ids merged`)).
+		WithArgs(survivorID, mergedID).
+		WillReturnRows(sqlmock.NewRows([]string{"namespace"}).AddRow("kbo").AddRow("wikidata"))
+	// 어느 한쪽의 식별자를 버리지 않도록 병합하지 않음
+	mock.ExpectRollback()
+
+	_, err = repo.MergePlayers(context.Background(), survivorID, mergedID)
+
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.FailedPreconditionError, customErr.Code)
+		assert.Equal(t, "Both players have external IDs in namespaces: kbo, wikidata", customErr.Message)
+	}
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetPlayerRedirect(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	mergedID, survivorID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT to_id FROM player_redirects WHERE from_id = $1`)).
+		WithArgs(mergedID).
+		WillReturnRows(sqlmock.NewRows([]string{"to_id"}).AddRow(survivorID))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT to_id FROM player_redirects WHERE from_id = $1`)).
+		WithArgs(survivorID).
+		WillReturnError(sql.ErrNoRows)
+
+	target, err := repo.GetPlayerRedirect(context.Background(), mergedID)
+	assert.NoError(t, err)
+	assert.Equal(t, survivorID, target)
+
+	_, err = repo.GetPlayerRedirect(context.Background(), survivorID)
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.NotFoundError, customErr.Code)
+	}
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/pgerr"
+)
+
+// ReplaceProfileImageURL implements playerRepo.PlayerRepository.
+// The URL is only replaced while it is still from, so that a URL submitted in the meantime is kept.
+func (r *playerRepository) ReplaceProfileImageURL(ctx context.Context, id uuid.UUID, from, to string, updatedAt time.Time) error {
+	if r.db == nil {
+		return errors.NewError(errors.NotConnectedError, "")
+	}
+
+	query := `
+        UPDATE players
+        SET profile_image_url = $1, updated_at = $2
+        WHERE id = $3 AND COALESCE(profile_image_url, '') = $4
+    `
+
+	result, err := r.db.ExecContext(ctx, query, to, updatedAt, id, from)
+	if err != nil {
+		return pgerr.Wrap("player.ReplaceProfileImageURL", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return errors.NewErrorWithArgs(errors.NotFoundError, "player not found or profile image changed")
+	}
+
+	return nil
+}
+
+// GetRemoteProfileImageURLs implements playerRepo.PlayerRepository.
+// Players are returned in ID order after the given ID, with only their ID and profile image URL.
+func (r *playerRepository) GetRemoteProfileImageURLs(ctx context.Context, after uuid.UUID, limit int) ([]*player.Player, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	query := `
+        SELECT id, profile_image_url
+        FROM players
+        WHERE id > $1 AND profile_image_url ~* '^https?://'
+        ORDER BY id
+        LIMIT $2
+    `
+
+	var players []*player.Player
+	if err := r.db.SelectContext(ctx, &players, query, after, limit); err != nil {
+		return nil, pgerr.Wrap("player.GetRemoteProfileImageURLs", err)
+	}
+
+	return players, nil
+}
+
+// SaveProfileImageLinkCheck implements playerRepo.PlayerRepository.
+// Only the latest check of each player is kept.
+func (r *playerRepository) SaveProfileImageLinkCheck(ctx context.Context, check *player.ProfileImageLinkCheck) error {
+	if r.db == nil {
+		return errors.NewError(errors.NotConnectedError, "")
+	}
+
+	query := `
+        INSERT INTO profile_image_link_checks (player_id, url, status_code, error, broken, checked_at)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        ON CONFLICT (player_id) DO UPDATE SET
+            url = EXCLUDED.url,
+            status_code = EXCLUDED.status_code,
+            error = EXCLUDED.error,
+            broken = EXCLUDED.broken,
+            checked_at = EXCLUDED.checked_at
+    `
+
+	_, err := r.db.ExecContext(ctx, query, check.PlayerID, check.URL, check.StatusCode, check.Error, check.Broken, check.CheckedAt)
+	if err != nil {
+		return pgerr.Wrap("player.SaveProfileImageLinkCheck", err)
+	}
+
+	return nil
+}
+
+// GetBrokenProfileImageLinks implements playerRepo.PlayerRepository.
+// Checks of URLs that have since been replaced are left out.
+func (r *playerRepository) GetBrokenProfileImageLinks(ctx context.Context) ([]*player.ProfileImageLinkCheck, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	query := `
+        SELECT c.player_id, c.url, c.status_code, c.error, c.broken, c.checked_at
+        FROM profile_image_link_checks c
+        JOIN players p ON p.id = c.player_id AND p.profile_image_url = c.url
+        WHERE c.broken
+        ORDER BY c.checked_at DESC, c.player_id
+    `
+
+	checks := []*player.ProfileImageLinkCheck{}
+	if err := r.db.SelectContext(ctx, &checks, query); err != nil {
+		return nil, pgerr.Wrap("player.GetBrokenProfileImageLinks", err)
+	}
+
+	return checks, nil
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	playerDom "player_management_system/internal/domains/players"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+func TestReplaceProfileImageURL(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	playerID, now := uuid.New(), time.Now()
+	from, to := "https://example.com/kim.jpg", "https://cdn.example.com/players/1/original.jpg"
+
+	query := regexp.QuoteMeta(`UPDATE players SET profile_image_url = $1, updated_at = $2 WHERE id = $3 AND COALESCE(profile_image_url, '') = $4`)
+	mock.ExpectExec(query).WithArgs(to, now, playerID, from).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(query).WithArgs(to, now, playerID, from).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.ReplaceProfileImageURL(context.Background(), playerID, from, to, now)
+	assert.NoError(t, err)
+
+	// 그 사이 다른 URL로 바뀐 경우
+	err = repo.ReplaceProfileImageURL(context.Background(), playerID, from, to, now)
+	assert.Equal(t, customErrors.NotFoundError, err.(*customErrors.Error).Code)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetRemoteProfileImageURLs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	after, playerID := uuid.Nil, uuid.New()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, profile_image_url FROM players WHERE id > $1 AND profile_image_url ~* '^https?://' ORDER BY id LIMIT $2`)).
+		WithArgs(after, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "profile_image_url"}).AddRow(playerID, "https://example.com/kim.jpg"))
+
+	players, err := repo.GetRemoteProfileImageURLs(context.Background(), after, 100)
+	assert.NoError(t, err)
+	if assert.Len(t, players, 1) {
+		assert.Equal(t, playerID, players[0].ID)
+		assert.Equal(t, "https://example.com/kim.jpg", players[0].ProfileImageURL)
+	}
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestSaveProfileImageLinkCheck(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	check := &playerDom.ProfileImageLinkCheck{PlayerID: uuid.New(), URL: "https://example.com/kim.jpg", StatusCode: 404, Broken: true, CheckedAt: time.Now()}
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO profile_image_link_checks (player_id, url, status_code, error, broken, checked_at) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (player_id) DO UPDATE SET`)).
+		WithArgs(check.PlayerID, check.URL, 404, "", true, check.CheckedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.SaveProfileImageLinkCheck(context.Background(), check)
+	assert.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetBrokenProfileImageLinks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	playerID, checkedAt := uuid.New(), time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT c.player_id, c.url, c.status_code, c.error, c.broken, c.checked_at FROM profile_image_link_checks c JOIN players p ON p.id = c.player_id AND p.profile_image_url = c.url WHERE c.broken`)).
+		WillReturnRows(sqlmock.NewRows([]string{"player_id", "url", "status_code", "error", "broken", "checked_at"}).
+			AddRow(playerID, "https://example.com/kim.jpg", 404, "", true, checkedAt))
+
+	checks, err := repo.GetBrokenProfileImageLinks(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, checks, 1) {
+		assert.Equal(t, playerID, checks[0].PlayerID)
+		assert.Equal(t, 404, checks[0].StatusCode)
+		assert.True(t, checks[0].Broken)
+	}
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/pgerr"
+)
+
+// GetTeamByName implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetTeamByName(ctx context.Context, name string) (*player.Team, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	var t player.Team
+	query := `
+        SELECT id, name, sport, COALESCE(city, '') AS city, COALESCE(stadium, '') AS stadium,
+               COALESCE(logo_url, '') AS logo_url, created_at, updated_at
+        FROM teams
+        WHERE name = $1
+    `
+
+	err := r.db.GetContext(ctx, &t, query, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrapf(errors.NotFoundError, "player.GetTeamByName", err, "team not found")
+		}
+		return nil, pgerr.Wrap("player.GetTeamByName", err)
+	}
+
+	return &t, nil
+}
+
+// GetLatestDescription implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetLatestDescription(ctx context.Context, playerID uuid.UUID) (*player.PlayerDescription, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	var d player.PlayerDescription
+	query := `
+        SELECT id, player_id, content, created_at, updated_at
+        FROM player_descriptions
+        WHERE player_id = $1
+        ORDER BY created_at DESC
+        LIMIT 1
+    `
+
+	err := r.db.GetContext(ctx, &d, query, playerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrapf(errors.NotFoundError, "player.GetLatestDescription", err, "description not found")
+		}
+		return nil, pgerr.Wrap("player.GetLatestDescription", err)
+	}
+
+	return &d, nil
+}
+
+// GetRecentMedia implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetRecentMedia(ctx context.Context, playerID uuid.UUID, limit int) ([]*player.Media, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	if limit <= 0 {
+		limit = 10
+	}
+
+	media := []*player.Media{}
+	query := `
+        SELECT id, player_id, source, url, title, COALESCE(content, '') AS content, published_at,
+               COALESCE(thumbnail_url, '') AS thumbnail_url, created_at, updated_at
+        FROM media
+        WHERE player_id = $1
+        ORDER BY published_at DESC
+        LIMIT $2
+    `
+
+	err := r.db.SelectContext(ctx, &media, query, playerID, limit)
+	if err != nil {
+		return nil, pgerr.Wrap("player.GetRecentMedia", err)
+	}
+
+	return media, nil
+}
+
+// GetSeasonStats implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetSeasonStats(ctx context.Context, playerID uuid.UUID, season int) (*player.SeasonStats, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	var s player.SeasonStats
+	query := `
+        SELECT player_id, season, games_played, stats, updated_at
+        FROM player_season_stats
+        WHERE player_id = $1 AND season = $2
+    `
+
+	err := r.db.GetContext(ctx, &s, query, playerID, season)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrapf(errors.NotFoundError, "player.GetSeasonStats", err, "season stats not found")
+		}
+		return nil, pgerr.Wrap("player.GetSeasonStats", err)
+	}
+
+	return &s, nil
+}
+
+// GetCurrentInjury implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetCurrentInjury(ctx context.Context, playerID uuid.UUID) (*player.Injury, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	var i player.Injury
+	query := `
+        SELECT id, player_id, status, COALESCE(description, '') AS description, injured_at, expected_return_at, resolved_at
+        FROM player_injuries
+        WHERE player_id = $1 AND resolved_at IS NULL
+        ORDER BY injured_at DESC
+        LIMIT 1
+    `
+
+	err := r.db.GetContext(ctx, &i, query, playerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrapf(errors.NotFoundError, "player.GetCurrentInjury", err, "injury not found")
+		}
+		return nil, pgerr.Wrap("player.GetCurrentInjury", err)
+	}
+
+	return &i, nil
+}
@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+func TestGetTeamByName(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	rows := sqlmock.NewRows([]string{"id", "name", "sport", "city", "stadium", "logo_url", "created_at", "updated_at"}).
+		AddRow(uuid.New(), "기아", "야구", "광주", "챔피언스 필드", "http://example.com/logo.png", time.Now(), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM teams WHERE name = $1`)).
+		WithArgs("기아").
+		WillReturnRows(rows)
+
+	team, err := repo.GetTeamByName(context.Background(), "기아")
+	assert.NoError(t, err)
+	assert.Equal(t, "광주", team.City)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetLatestDescription_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	playerID := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM player_descriptions WHERE player_id = $1 ORDER BY created_at DESC LIMIT 1`)).
+		WithArgs(playerID).
+		WillReturnError(sql.ErrNoRows)
+
+	description, err := repo.GetLatestDescription(context.Background(), playerID)
+	assert.Nil(t, description)
+
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.NotFoundError, customErr.Code)
+	}
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetRecentMedia(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	playerID := uuid.New()
+	rows := sqlmock.NewRows([]string{"id", "player_id", "source", "url", "title", "content", "published_at", "thumbnail_url", "created_at", "updated_at"}).
+		AddRow(uuid.New(), playerID, "news", "http://example.com/1", "Title 1", "", time.Now(), "", time.Now(), time.Now()).
+		AddRow(uuid.New(), playerID, "news", "http://example.com/2", "Title 2", "", time.Now(), "", time.Now(), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM media WHERE player_id = $1 ORDER BY published_at DESC LIMIT $2`)).
+		WithArgs(playerID, 10).
+		WillReturnRows(rows)
+
+	media, err := repo.GetRecentMedia(context.Background(), playerID, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(media))
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetSeasonStats(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	playerID := uuid.New()
+	rows := sqlmock.NewRows([]string{"player_id", "season", "games_played", "stats", "updated_at"}).
+		AddRow(playerID, 2024, 141, []byte(`{"avg":0.347}`), time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM player_season_stats WHERE player_id = $1 AND season = $2`)).
+		WithArgs(playerID, 2024).
+		WillReturnRows(rows)
+
+	stats, err := repo.GetSeasonStats(context.Background(), playerID, 2024)
+	assert.NoError(t, err)
+	assert.Equal(t, 141, stats.GamesPlayed)
+	assert.JSONEq(t, `{"avg":0.347}`, string(stats.Stats))
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetCurrentInjury(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	playerID := uuid.New()
+	rows := sqlmock.NewRows([]string{"id", "player_id", "status", "description", "injured_at", "expected_return_at", "resolved_at"}).
+		AddRow(uuid.New(), playerID, "out", "hamstring", time.Now(), nil, nil)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM player_injuries WHERE player_id = $1 AND resolved_at IS NULL`)).
+		WithArgs(playerID).
+		WillReturnRows(rows)
+
+	injury, err := repo.GetCurrentInjury(context.Background(), playerID)
+	assert.NoError(t, err)
+	assert.Equal(t, "out", injury.Status)
+	assert.Nil(t, injury.ExpectedReturnAt)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,273 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/pgerr"
+)
+
+// GetPlayerByIDWithOptions implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetPlayerByIDWithOptions(ctx context.Context, id uuid.UUID, opts player.ReadOptions) (*player.Player, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	var p player.Player
+	query := fmt.Sprintf(`
+        SELECT %s
+        FROM players
+        WHERE id = $1
+    `, selectColumns(opts))
+
+	err := r.db.GetContext(ctx, &p, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrapf(errors.NotFoundError, "player.GetPlayerByIDWithOptions", err, "player not found")
+		}
+		return nil, pgerr.Wrap("player.GetPlayerByIDWithOptions", err)
+	}
+
+	if err := r.loadRelations(ctx, []*player.Player{&p}, opts); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// GetPlayersWithOptions implements playerRepo.PlayerRepository.
+func (r *playerRepository) GetPlayersWithOptions(ctx context.Context, page, pageSize int, filter player.PlayerFilter, opts player.ReadOptions) ([]*player.Player, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "database connection is not established")
+	}
+
+	limit, offset := pageWindow(page, pageSize)
+
+	where, args := filterClause(filter)
+	args = append(args, limit, offset)
+
+	var players []*player.Player
+	query := fmt.Sprintf(`
+        SELECT %s
+        FROM players
+        %s
+        ORDER BY created_at, id
+        LIMIT $%d OFFSET $%d
+    `, selectColumns(opts), where, len(args)-1, len(args))
+
+	err := r.db.SelectContext(ctx, &players, query, args...)
+	if err != nil {
+		return nil, pgerr.Wrap("player.GetPlayersWithOptions", err)
+	}
+
+	if err := r.loadRelations(ctx, players, opts); err != nil {
+		return nil, err
+	}
+
+	return players, nil
+}
+
+// pageWindow returns the LIMIT and OFFSET of a page. Pages start at 1, and the page size defaults to
+// player.DefaultPageSize and is capped at player.MaxPageSize.
+func pageWindow(page, pageSize int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = player.DefaultPageSize
+	}
+	if pageSize > player.MaxPageSize {
+		pageSize = player.MaxPageSize
+	}
+	return pageSize, (page - 1) * pageSize
+}
+
+// filterClause builds the WHERE clause of a player filter and its arguments, numbered from $1.
+func filterClause(filter player.PlayerFilter) (string, []interface{}) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	if filter.Name != "" {
+		// 별칭으로도 검색됨
+		args = append(args, "%"+escapeLike(filter.Name)+"%")
+		conditions = append(conditions, fmt.Sprintf(
+			"(name ILIKE $%[1]d OR EXISTS (SELECT 1 FROM player_aliases WHERE player_aliases.player_id = players.id AND player_aliases.name ILIKE $%[1]d))",
+			len(args)))
+	}
+	if filter.Sport != "" {
+		args = append(args, filter.Sport)
+		conditions = append(conditions, fmt.Sprintf("sport = $%d", len(args)))
+	}
+	if filter.Team != "" {
+		args = append(args, filter.Team)
+		conditions = append(conditions, fmt.Sprintf("team = $%d", len(args)))
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// escapeLike escapes the LIKE wildcards in s.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// nullableColumns maps nullable player columns, and fields stored in other tables, to their select expressions.
+var nullableColumns = map[string]string{
+	"profile_image_url": "COALESCE(profile_image_url, '') AS profile_image_url",
+	"external_id": `COALESCE((SELECT value FROM player_external_ids
+                      WHERE player_external_ids.player_id = players.id AND namespace = '` + player.NamespaceDefault + `'), '') AS external_id`,
+}
+
+// selectColumns builds the column list for a sparse fieldset.
+// Only whitelisted fields are used, and id is always selected so that relations can be attached.
+func selectColumns(opts player.ReadOptions) string {
+	columns := []string{"id"}
+	for _, f := range player.PlayerFields {
+		if f == "id" || !opts.Selects(f) {
+			continue
+		}
+		if expr, ok := nullableColumns[f]; ok {
+			f = expr
+		}
+		columns = append(columns, f)
+	}
+	return strings.Join(columns, ", ")
+}
+
+// loadRelations attaches the expanded relations to the players with one query per relation.
+func (r *playerRepository) loadRelations(ctx context.Context, players []*player.Player, opts player.ReadOptions) error {
+	if len(players) == 0 || len(opts.Expand) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(players))
+	byID := make(map[uuid.UUID]*player.Player, len(players))
+	for _, p := range players {
+		ids = append(ids, p.ID)
+		byID[p.ID] = p
+	}
+
+	if opts.Expands(player.ExpandDescriptions) {
+		var descriptions []*player.PlayerDescription
+		query := `
+            SELECT id, player_id, content, created_at, updated_at
+            FROM player_descriptions
+            WHERE player_id IN (?)
+            ORDER BY created_at DESC
+        `
+		if err := r.selectIn(ctx, &descriptions, query, ids); err != nil {
+			return err
+		}
+
+		for _, p := range players {
+			p.Descriptions = []*player.PlayerDescription{}
+		}
+		for _, d := range descriptions {
+			if p, ok := byID[d.PlayerID]; ok {
+				p.Descriptions = append(p.Descriptions, d)
+			}
+		}
+	}
+
+	if opts.Expands(player.ExpandMedia) {
+		// 선수마다 최근 미디어만 가져옴
+		var media []*player.Media
+		query := fmt.Sprintf(`
+            SELECT id, player_id, source, url, title, content, published_at, thumbnail_url, created_at, updated_at
+            FROM (
+                SELECT id, player_id, source, url, title, COALESCE(content, '') AS content, published_at,
+                       COALESCE(thumbnail_url, '') AS thumbnail_url, created_at, updated_at,
+                       ROW_NUMBER() OVER (PARTITION BY player_id ORDER BY published_at DESC, id) AS rank
+                FROM media
+                WHERE player_id IN (?)
+            ) ranked
+            WHERE rank <= %d
+            ORDER BY published_at DESC, id
+        `, player.MaxExpandedMedia)
+		if err := r.selectIn(ctx, &media, query, ids); err != nil {
+			return err
+		}
+
+		for _, p := range players {
+			p.Media = []*player.Media{}
+		}
+		for _, m := range media {
+			if p, ok := byID[m.PlayerID]; ok {
+				p.Media = append(p.Media, m)
+			}
+		}
+	}
+
+	if opts.Expands(player.ExpandExternalIDs) {
+		var externalIDs []*player.ExternalIdentifier
+		query := `
+            SELECT player_id, namespace, value
+            FROM player_external_ids
+            WHERE player_id IN (?)
+            ORDER BY namespace
+        `
+		if err := r.selectIn(ctx, &externalIDs, query, ids); err != nil {
+			return err
+		}
+
+		for _, p := range players {
+			p.ExternalIDs = []*player.ExternalIdentifier{}
+		}
+		for _, x := range externalIDs {
+			if p, ok := byID[x.PlayerID]; ok {
+				p.ExternalIDs = append(p.ExternalIDs, x)
+			}
+		}
+	}
+
+	if opts.Expands(player.ExpandAliases) {
+		var aliases []*player.Alias
+		query := `
+            SELECT id, player_id, name, type, locale, is_primary
+            FROM player_aliases
+            WHERE player_id IN (?)
+            ORDER BY is_primary DESC, locale, name
+        `
+		if err := r.selectIn(ctx, &aliases, query, ids); err != nil {
+			return err
+		}
+
+		for _, p := range players {
+			p.Aliases = []*player.Alias{}
+		}
+		for _, a := range aliases {
+			if p, ok := byID[a.PlayerID]; ok {
+				p.Aliases = append(p.Aliases, a)
+			}
+		}
+	}
+
+	return nil
+}
+
+// selectIn runs a query whose single IN (?) placeholder is expanded to the given ids.
+func (r *playerRepository) selectIn(ctx context.Context, dest interface{}, query string, ids []uuid.UUID) error {
+	query, args, err := sqlx.In(query, ids)
+	if err != nil {
+		return errors.Wrap(errors.InternalError, "player.selectIn", err)
+	}
+
+	err = r.db.SelectContext(ctx, dest, r.db.Rebind(query), args...)
+	if err != nil {
+		return pgerr.Wrap("player.selectIn", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	playerDom "player_management_system/internal/domains/players"
+)
+
+func TestGetPlayersWithOptions_SparseFields(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+	repo := NewPlayerRepository(sqlxDB)
+
+	rows := sqlmock.NewRows([]string{"id", "name"}).
+		AddRow(uuid.New(), "Test Player 1").
+		AddRow(uuid.New(), "Test Player 2")
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name FROM players ORDER BY created_at, id LIMIT $1 OFFSET $2`)).
+		WithArgs(10, 0).
+		WillReturnRows(rows)
+
+	players, err := repo.GetPlayersWithOptions(context.Background(), 1, 10, playerDom.PlayerFilter{}, playerDom.ReadOptions{Fields: []string{"name"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(players))
+	assert.Equal(t, "Test Player 2", players[1].Name)
+	assert.Empty(t, players[1].Team)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetPlayersWithOptions_Expand(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+	repo := NewPlayerRepository(sqlxDB)
+
+	firstID, secondID := uuid.New(), uuid.New()
+	rows := sqlmock.NewRows([]string{"id", "name"}).
+		AddRow(firstID, "Test Player 1").
+		AddRow(secondID, "Test Player 2")
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name FROM players ORDER BY created_at, id LIMIT $1 OFFSET $2`)).
+		WithArgs(10, 0).
+		WillReturnRows(rows)
+
+	descriptionRows := sqlmock.NewRows([]string{"id", "player_id", "content", "created_at", "updated_at"}).
+		AddRow(uuid.New(), firstID, "first", time.Now(), time.Now()).
+		AddRow(uuid.New(), firstID, "second", time.Now(), time.Now())
+
+	// One query for all players, not one per player.
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM player_descriptions WHERE player_id IN ($1, $2)`)).
+		WithArgs(firstID, secondID).
+		WillReturnRows(descriptionRows)
+
+	mediaRows := sqlmock.NewRows([]string{"id", "player_id", "source", "url", "title", "content", "published_at", "thumbnail_url", "created_at", "updated_at"}).
+		AddRow(uuid.New(), secondID, "news", "http://example.com/1", "Title", "", time.Now(), "", time.Now(), time.Now())
+
+	// 선수마다 최근 미디어만 가져옴
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM media WHERE player_id IN ($1, $2) ) ranked WHERE rank <= 20`)).
+		WithArgs(firstID, secondID).
+		WillReturnRows(mediaRows)
+
+	opts := playerDom.ReadOptions{Fields: []string{"name"}, Expand: []string{playerDom.ExpandDescriptions, playerDom.ExpandMedia}}
+	players, err := repo.GetPlayersWithOptions(context.Background(), 1, 10, playerDom.PlayerFilter{}, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(players[0].Descriptions))
+	assert.Empty(t, players[0].Media)
+	assert.Empty(t, players[1].Descriptions)
+	assert.Equal(t, 1, len(players[1].Media))
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetPlayerByIDWithOptions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+	repo := NewPlayerRepository(sqlxDB)
+
+	playerID := uuid.New()
+	rows := sqlmock.NewRows([]string{"id", "name", "team"}).
+		AddRow(playerID, "Test Player", "Test Team")
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, team FROM players WHERE id = $1`)).
+		WithArgs(playerID).
+		WillReturnRows(rows)
+
+	p, err := repo.GetPlayerByIDWithOptions(context.Background(), playerID, playerDom.ReadOptions{Fields: []string{"name", "team"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Test Team", p.Team)
+	assert.Nil(t, p.Descriptions)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetPlayersWithOptions_Filter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+	repo := NewPlayerRepository(sqlxDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name FROM players WHERE (name ILIKE $1 OR EXISTS (SELECT 1 FROM player_aliases WHERE player_aliases.player_id = players.id AND player_aliases.name ILIKE $1)) AND sport = $2 ORDER BY created_at, id LIMIT $3 OFFSET $4`)).
+		WithArgs(`%50\%%`, "야구", 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+
+	filter := playerDom.PlayerFilter{Name: "50%", Sport: "야구"}
+	players, err := repo.GetPlayersWithOptions(context.Background(), 1, 10, filter, playerDom.ReadOptions{Fields: []string{"name"}})
+	assert.NoError(t, err)
+	assert.Empty(t, players)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetPlayersWithOptions_PageSizeCap(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "postgres")
+	repo := NewPlayerRepository(sqlxDB)
+
+	// 페이지 크기는 최대값으로 제한됨
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name FROM players ORDER BY created_at, id LIMIT $1 OFFSET $2`)).
+		WithArgs(playerDom.MaxPageSize, 2*playerDom.MaxPageSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+
+	_, err = repo.GetPlayersWithOptions(context.Background(), 3, 10000, playerDom.PlayerFilter{}, playerDom.ReadOptions{Fields: []string{"name"}})
+	assert.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
@@ -3,12 +3,15 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 
 	"player_management_system/internal/domains/players"
 	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/pgerr"
 	playerRepo "player_management_system/internal/repositories/player"
 )
 
@@ -27,26 +30,25 @@ func (r *playerRepository) CreatePlayer(ctx context.Context, p *player.Player) e
 	}
 
 	query := `
-        INSERT INTO players (id, name, sport, team, profile_image_url, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        INSERT INTO players (id, name, sport, team, profile_image_url, birth_date, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
     `
 
-	_, err := r.db.ExecContext(
-		ctx,
-		query,
-		p.ID,
-		p.Name,
-		p.Sport,
-		p.Team,
-		p.ProfileImageURL,
-		p.CreatedAt,
-		p.UpdatedAt,
-	)
-	if err != nil {
-		return errors.NewErrorWithArgs(errors.DatabaseError, err.Error()) // DatabaseError 사용
-	}
-
-	return nil
+	return r.writePlayer(ctx, p, func(db sqlx.ExecerContext) error {
+		_, err := db.ExecContext(
+			ctx,
+			query,
+			p.ID,
+			p.Name,
+			p.Sport,
+			p.Team,
+			p.ProfileImageURL,
+			p.BirthDate,
+			p.CreatedAt,
+			p.UpdatedAt,
+		)
+		return err
+	})
 }
 
 // DeletePlayer implements playerRepo.PlayerRepository.
@@ -62,7 +64,7 @@ func (r *playerRepository) DeletePlayer(ctx context.Context, id uuid.UUID) error
 
 	_, err := r.db.ExecContext(ctx, query, id)
 	if err != nil {
-		return errors.NewErrorWithArgs(errors.DatabaseError, err.Error())
+		return pgerr.Wrap("player.DeletePlayer", err)
 	}
 
 	return nil
@@ -75,18 +77,18 @@ func (r *playerRepository) GetPlayerByID(ctx context.Context, id uuid.UUID) (*pl
 	}
 
 	var p player.Player
-	query := `
-        SELECT id, name, sport, team, profile_image_url, created_at, updated_at
+	query := fmt.Sprintf(`
+        SELECT %s
         FROM players
         WHERE id = $1
-    `
+    `, selectColumns(player.ReadOptions{}))
 
 	err := r.db.GetContext(ctx, &p, query, id)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, errors.NewErrorWithArgs(errors.NotFoundError, "player not found")
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrapf(errors.NotFoundError, "player.GetPlayerByID", err, "player not found")
 		}
-		return nil, errors.NewErrorWithArgs(errors.DatabaseError, err.Error())
+		return nil, pgerr.Wrap("player.GetPlayerByID", err)
 	}
 
 	return &p, nil
@@ -106,7 +108,7 @@ func (r *playerRepository) GetPlayers(ctx context.Context) ([]*player.Player, er
 
 	err := r.db.SelectContext(ctx, &players, query)
 	if err != nil {
-		return nil, errors.NewErrorWithArgs(errors.DatabaseError, err.Error())
+		return nil, pgerr.Wrap("player.GetPlayers", err)
 	}
 
 	return players, nil
@@ -120,22 +122,44 @@ func (r *playerRepository) UpdatePlayer(ctx context.Context, p *player.Player) e
 
 	query := `
         UPDATE players
-        SET name = $1, sport = $2, team = $3, profile_image_url = $4, updated_at = $5
-        WHERE id = $6
+        SET name = $1, sport = $2, team = $3, profile_image_url = $4, birth_date = $5, updated_at = $6
+        WHERE id = $7
     `
 
-	_, err := r.db.ExecContext(
-		ctx,
-		query,
-		p.Name,
-		p.Sport,
-		p.Team,
-		p.ProfileImageURL,
-		p.UpdatedAt,
-		p.ID,
-	)
+	return r.writePlayer(ctx, p, func(db sqlx.ExecerContext) error {
+		_, err := db.ExecContext(
+			ctx,
+			query,
+			p.Name,
+			p.Sport,
+			p.Team,
+			p.ProfileImageURL,
+			p.BirthDate,
+			p.UpdatedAt,
+			p.ID,
+		)
+		return err
+	})
+}
+
+// UpdateProfileImageURL implements playerRepo.PlayerRepository.
+func (r *playerRepository) UpdateProfileImageURL(ctx context.Context, id uuid.UUID, url string, updatedAt time.Time) error {
+	if r.db == nil {
+		return errors.NewError(errors.NotConnectedError, "")
+	}
+
+	query := `
+        UPDATE players
+        SET profile_image_url = $1, updated_at = $2
+        WHERE id = $3
+    `
+
+	result, err := r.db.ExecContext(ctx, query, url, updatedAt, id)
 	if err != nil {
-		return errors.NewErrorWithArgs(errors.DatabaseError, err.Error())
+		return pgerr.Wrap("player.UpdateProfileImageURL", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return errors.NewErrorWithArgs(errors.NotFoundError, "player not found")
 	}
 
 	return nil
@@ -147,26 +171,85 @@ func (r *playerRepository) GetPlayersWithPagination(ctx context.Context, page, p
 		return nil, errors.NewError(errors.NotConnectedError, "database connection is not established")
 	}
 
-	if page <= 0 {
-		page = 1
-	}
-	if pageSize <= 0 {
-		pageSize = 10 // 기본값 설정
-	}
-
-	offset := (page - 1) * pageSize
+	limit, offset := pageWindow(page, pageSize)
 
 	var players []*player.Player
 	query := `
         SELECT id, name, sport, team, profile_image_url, created_at, updated_at
         FROM players
+        ORDER BY created_at, id
         LIMIT $1 OFFSET $2
     `
 
-	err := r.db.SelectContext(ctx, &players, query, pageSize, offset)
+	err := r.db.SelectContext(ctx, &players, query, limit, offset)
 	if err != nil {
-		return nil, errors.NewErrorWithArgs(errors.DatabaseError, err.Error())
+		return nil, pgerr.Wrap("player.GetPlayersWithPagination", err)
 	}
 
 	return players, nil
 }
+
+// CountPlayersBySport implements playerRepo.PlayerRepository.
+func (r *playerRepository) CountPlayersBySport(ctx context.Context) (map[string]int, error) {
+	if r.db == nil {
+		return nil, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	var rows []struct {
+		Sport string `db:"sport"`
+		Count int    `db:"count"`
+	}
+	query := `
+        SELECT sport, COUNT(*) AS count
+        FROM players
+        GROUP BY sport
+    `
+
+	err := r.db.SelectContext(ctx, &rows, query)
+	if err != nil {
+		return nil, pgerr.Wrap("player.CountPlayersBySport", err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Sport] = row.Count
+	}
+
+	return counts, nil
+}
+
+// writePlayer runs a player write and, in the same transaction, replaces the player's external
+// identifiers and aliases unless they are nil.
+func (r *playerRepository) writePlayer(ctx context.Context, p *player.Player, write func(db sqlx.ExecerContext) error) error {
+	if p.ExternalIDs == nil && p.Aliases == nil {
+		if err := write(r.db); err != nil {
+			return pgerr.Wrap("player.writePlayer", err)
+		}
+		return nil
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return pgerr.Wrap("player.writePlayer", err)
+	}
+	defer tx.Rollback()
+
+	if err := write(tx); err != nil {
+		return pgerr.Wrap("player.writePlayer", err)
+	}
+	if p.ExternalIDs != nil {
+		if err := replaceExternalIDs(ctx, tx, p.ID, p.ExternalIDs); err != nil {
+			return err
+		}
+	}
+	if p.Aliases != nil {
+		if err := replaceAliases(ctx, tx, p.ID, p.Aliases); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return pgerr.Wrap("player.writePlayer", err)
+	}
+	return nil
+}
@@ -2,16 +2,22 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"github.com/jmoiron/sqlx"
+	"net"
+	"net/http"
 	"regexp"
+	"syscall"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 
 	playerDom "player_management_system/internal/domains/players"
+	customErrors "player_management_system/internal/pkg/errors"
 )
 
 func TestCreatePlayer(t *testing.T) {
@@ -38,8 +44,8 @@ func TestCreatePlayer(t *testing.T) {
 	}
 
 	// Expect the query to be executed with the correct parameters
-	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO players (id, name, sport, team, profile_image_url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7)`)).
-		WithArgs(p.ID, p.Name, p.Sport, p.Team, p.ProfileImageURL, p.CreatedAt, p.UpdatedAt).
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO players (id, name, sport, team, profile_image_url, birth_date, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`)).
+		WithArgs(p.ID, p.Name, p.Sport, p.Team, p.ProfileImageURL, p.BirthDate, p.CreatedAt, p.UpdatedAt).
 		WillReturnResult(sqlmock.NewResult(1, 1)) // 1 row affected
 
 	// Test CreatePlayer
@@ -51,6 +57,69 @@ func TestCreatePlayer(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreatePlayer_DatabaseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   customErrors.ErrorCode
+		status int
+	}{
+		{"unique violation", &pq.Error{Code: "23505", Constraint: "players_pkey"}, customErrors.AlreadyExistsError, http.StatusConflict},
+		{"missing reference", &pq.Error{Code: "23503", Detail: `Key (team_id)=(1) is not present in table "teams".`}, customErrors.InvalidArgumentError, http.StatusBadRequest},
+		{"not null violation", &pq.Error{Code: "23502"}, customErrors.InvalidArgumentError, http.StatusBadRequest},
+		{"value too long", &pq.Error{Code: "22001"}, customErrors.InvalidArgumentError, http.StatusBadRequest},
+		{"serialization failure", &pq.Error{Code: "40001"}, customErrors.AbortedError, http.StatusConflict},
+		{"deadlock", &pq.Error{Code: "40P01"}, customErrors.AbortedError, http.StatusConflict},
+		{"statement timeout", &pq.Error{Code: "57014"}, customErrors.TimeoutError, http.StatusGatewayTimeout},
+		{"connection failure", &pq.Error{Code: "08006"}, customErrors.NotConnectedError, http.StatusServiceUnavailable},
+		{"admin shutdown", &pq.Error{Code: "57P01"}, customErrors.NotConnectedError, http.StatusServiceUnavailable},
+		{"too many connections", &pq.Error{Code: "53300"}, customErrors.NotConnectedError, http.StatusServiceUnavailable},
+		{"connection refused", &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, customErrors.NotConnectedError, http.StatusServiceUnavailable},
+		{"undefined table", &pq.Error{Code: "42P01"}, customErrors.DatabaseError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			repo := NewPlayerRepository(sqlx.NewDb(db, "sqlmock"))
+			p := &playerDom.Player{ID: uuid.New(), Name: "Test Player", Sport: "Football", Team: "Test Team"}
+
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO players`)).WillReturnError(tt.err)
+
+			// 실행
+			err = repo.CreatePlayer(context.Background(), p)
+
+			// 검증
+			assert.ErrorIs(t, err, tt.code)
+			assert.Equal(t, tt.status, customErrors.GetHTTPStatusCode(err))
+			assert.Equal(t, "player.writePlayer", err.(*customErrors.Error).Op)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDeletePlayer_StillReferenced(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewPlayerRepository(sqlx.NewDb(db, "sqlmock"))
+	playerID := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM players WHERE id = $1`)).
+		WithArgs(playerID).
+		WillReturnError(&pq.Error{Code: "23503", Detail: `Key (id)=(1) is still referenced from table "contracts".`, Table: "players"})
+
+	err = repo.DeletePlayer(context.Background(), playerID)
+
+	assert.ErrorIs(t, err, customErrors.FailedPreconditionError)
+	assert.Equal(t, "players", err.(*customErrors.Error).Details["table"])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetPlayerByID(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	assert.NoError(t, err)
@@ -60,10 +129,12 @@ func TestGetPlayerByID(t *testing.T) {
 	repo := NewPlayerRepository(sqlxDB)
 
 	playerID := uuid.New()
-	rows := sqlmock.NewRows([]string{"id", "name", "sport", "team", "profile_image_url", "created_at", "updated_at"}). // profile_image_url 추가
-																AddRow(playerID, "Test Player", "Football", "Test Team", "http://example.com/image.jpg", time.Now(), time.Now())
+	birthDate := time.Date(1994, 5, 20, 0, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "name", "sport", "team", "profile_image_url", "external_id", "birth_date", "created_at", "updated_at"}). // profile_image_url 추가
+																			AddRow(playerID, "Test Player", "Football", "Test Team", "http://example.com/image.jpg", "kbo-1", birthDate, time.Now(), time.Now())
 
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, sport, team, profile_image_url, created_at, updated_at FROM players WHERE id = $1`)).
+	// 목록 조회와 같은 필드를 모두 읽음
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, sport, team, COALESCE(profile_image_url, '') AS profile_image_url, COALESCE((SELECT value FROM player_external_ids WHERE player_external_ids.player_id = players.id AND namespace = 'default'), '') AS external_id, birth_date, created_at, updated_at FROM players WHERE id = $1`)).
 		WithArgs(playerID).
 		WillReturnRows(rows)
 
@@ -71,6 +142,37 @@ func TestGetPlayerByID(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, player)
 	assert.Equal(t, "Test Player", player.Name)
+	assert.Equal(t, "kbo-1", player.ExternalID)
+	assert.Equal(t, &birthDate, player.BirthDate)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestGetPlayerByID_ErrorCauses(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	playerID := uuid.New()
+	dbErr := &pq.Error{Code: "XX000", Message: "internal error"}
+	query := regexp.QuoteMeta(`FROM players WHERE id = $1`)
+	mock.ExpectQuery(query).WithArgs(playerID).WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(query).WithArgs(playerID).WillReturnError(dbErr)
+
+	// 코드와 원인 모두로 확인할 수 있음
+	_, err = repo.GetPlayerByID(context.Background(), playerID)
+	assert.ErrorIs(t, err, customErrors.NotFoundError)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Equal(t, "player not found", err.(*customErrors.Error).Message)
+
+	_, err = repo.GetPlayerByID(context.Background(), playerID)
+	assert.ErrorIs(t, err, customErrors.DatabaseError)
+	assert.ErrorIs(t, err, dbErr)
+	assert.Equal(t, "player.GetPlayerByID", err.(*customErrors.Error).Op)
 
 	err = mock.ExpectationsWereMet()
 	assert.NoError(t, err)
@@ -94,8 +196,8 @@ func TestUpdatePlayer(t *testing.T) {
 		UpdatedAt:       time.Now(),
 	}
 
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE players SET name = $1, sport = $2, team = $3, profile_image_url = $4, updated_at = $5 WHERE id = $6`)).
-		WithArgs(p.Name, p.Sport, p.Team, p.ProfileImageURL, p.UpdatedAt, p.ID).
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE players SET name = $1, sport = $2, team = $3, profile_image_url = $4, birth_date = $5, updated_at = $6 WHERE id = $7`)).
+		WithArgs(p.Name, p.Sport, p.Team, p.ProfileImageURL, p.BirthDate, p.UpdatedAt, p.ID).
 		WillReturnResult(sqlmock.NewResult(0, 1)) // 1 row affected
 
 	err = repo.UpdatePlayer(context.Background(), p)
@@ -105,6 +207,35 @@ func TestUpdatePlayer(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestUpdateProfileImageURL(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	playerID, now := uuid.New(), time.Now()
+	url := "https://cdn.example.com/players/1/original.jpg"
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE players SET profile_image_url = $1, updated_at = $2 WHERE id = $3`)).
+		WithArgs(url, now, playerID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE players SET profile_image_url = $1, updated_at = $2 WHERE id = $3`)).
+		WithArgs(url, now, playerID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.UpdateProfileImageURL(context.Background(), playerID, url, now)
+	assert.NoError(t, err)
+
+	// 삭제된 선수
+	err = repo.UpdateProfileImageURL(context.Background(), playerID, url, now)
+	assert.Equal(t, customErrors.NotFoundError, err.(*customErrors.Error).Code)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
 func TestDeletePlayer(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	assert.NoError(t, err)
@@ -149,3 +280,26 @@ func TestGetPlayers(t *testing.T) {
 	err = mock.ExpectationsWereMet()
 	assert.NoError(t, err)
 }
+
+func TestCountPlayersBySport(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	rows := sqlmock.NewRows([]string{"sport", "count"}).
+		AddRow("Football", 3).
+		AddRow("Basketball", 1)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT sport, COUNT(*) AS count FROM players GROUP BY sport`)).
+		WillReturnRows(rows)
+
+	counts, err := repo.CountPlayersBySport(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"Football": 3, "Basketball": 1}, counts)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/pgerr"
+)
+
+// streamFetchSize is the number of rows fetched from the cursor at a time.
+const streamFetchSize = 500
+
+// StreamPlayers implements playerRepo.PlayerRepository.
+// Rows are read in chunks through a server-side cursor, so memory use does not grow with the table.
+// Expanded relations are loaded for each chunk.
+func (r *playerRepository) StreamPlayers(ctx context.Context, filter player.PlayerFilter, opts player.ReadOptions, fn func(*player.Player) error) error {
+	if r.db == nil {
+		return errors.NewError(errors.NotConnectedError, "")
+	}
+
+	// 커서는 트랜잭션 안에서만 유효함
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return pgerr.Wrap("player.StreamPlayers", err)
+	}
+	defer tx.Rollback()
+
+	where, args := filterClause(filter)
+	declare := fmt.Sprintf(`
+        DECLARE player_stream NO SCROLL CURSOR FOR
+        SELECT %s
+        FROM players
+        %s
+        ORDER BY created_at, id
+    `, selectColumns(opts), where)
+
+	if _, err := tx.ExecContext(ctx, declare, args...); err != nil {
+		return pgerr.Wrap("player.StreamPlayers", err)
+	}
+
+	fetch := fmt.Sprintf(`FETCH FORWARD %d FROM player_stream`, streamFetchSize)
+	for {
+		rows, err := tx.QueryxContext(ctx, fetch)
+		if err != nil {
+			return pgerr.Wrap("player.StreamPlayers", err)
+		}
+
+		chunk := make([]*player.Player, 0, streamFetchSize)
+		for rows.Next() {
+			var p player.Player
+			if err := rows.StructScan(&p); err != nil {
+				rows.Close()
+				return pgerr.Wrap("player.StreamPlayers", err)
+			}
+			chunk = append(chunk, &p)
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return pgerr.Wrap("player.StreamPlayers", err)
+		}
+		rows.Close()
+
+		if err := r.loadRelations(ctx, chunk, opts); err != nil {
+			return err
+		}
+		for _, p := range chunk {
+			if err := fn(p); err != nil {
+				return err
+			}
+		}
+
+		if len(chunk) < streamFetchSize {
+			break
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return pgerr.Wrap("player.StreamPlayers", err)
+	}
+
+	return nil
+}
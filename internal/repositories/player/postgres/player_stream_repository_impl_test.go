@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	playerDom "player_management_system/internal/domains/players"
+)
+
+func TestStreamPlayers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DECLARE player_stream NO SCROLL CURSOR FOR SELECT id, name, team FROM players WHERE team = $1 ORDER BY created_at, id`)).
+		WithArgs("Test Team").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// A full chunk makes the repository fetch again; a short chunk ends the stream.
+	fullChunk := sqlmock.NewRows([]string{"id", "name", "team"})
+	for i := 0; i < streamFetchSize; i++ {
+		fullChunk.AddRow(uuid.New(), fmt.Sprintf("Player %d", i), "Test Team")
+	}
+	lastChunk := sqlmock.NewRows([]string{"id", "name", "team"}).
+		AddRow(uuid.New(), "Last Player", "Test Team")
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FETCH FORWARD 500 FROM player_stream`)).WillReturnRows(fullChunk)
+	mock.ExpectQuery(regexp.QuoteMeta(`FETCH FORWARD 500 FROM player_stream`)).WillReturnRows(lastChunk)
+	mock.ExpectCommit()
+
+	var names []string
+	opts := playerDom.ReadOptions{Fields: []string{"name", "team"}}
+	err = repo.StreamPlayers(context.Background(), playerDom.PlayerFilter{Team: "Test Team"}, opts, func(p *playerDom.Player) error {
+		names = append(names, p.Name)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, names, streamFetchSize+1)
+	assert.Equal(t, "Last Player", names[streamFetchSize])
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestStreamPlayers_CallbackError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repo := NewPlayerRepository(sqlxDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DECLARE player_stream`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`FETCH FORWARD 500 FROM player_stream`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_at"}).AddRow(uuid.New(), "Test Player", time.Now()))
+	mock.ExpectRollback()
+
+	writeErr := fmt.Errorf("client went away")
+	err = repo.StreamPlayers(context.Background(), playerDom.PlayerFilter{}, playerDom.ReadOptions{Fields: []string{"name", "created_at"}}, func(p *playerDom.Player) error {
+		return writeErr
+	})
+	assert.Equal(t, writeErr, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
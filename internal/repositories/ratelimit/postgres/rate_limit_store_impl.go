@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/pgerr"
+	"player_management_system/internal/pkg/ratelimit"
+)
+
+// rateLimitStore keeps the token buckets in Postgres so that all replicas share them.
+type rateLimitStore struct {
+	db *sqlx.DB
+}
+
+func NewRateLimitStore(db *sqlx.DB) ratelimit.Store {
+	return &rateLimitStore{db: db}
+}
+
+// Take implements ratelimit.Store.
+// The bucket row is locked for the duration of the transaction, so concurrent requests
+// from different replicas take tokens one after another. The row records when the bucket
+// will be full again, after which Sweep deletes it.
+func (s *rateLimitStore) Take(ctx context.Context, key string, limit ratelimit.Limit, now time.Time) (ratelimit.Result, error) {
+	if s.db == nil {
+		return ratelimit.Result{}, errors.NewError(errors.NotConnectedError, "")
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return ratelimit.Result{}, pgerr.Wrap("rateLimit.Take", err)
+	}
+	defer tx.Rollback()
+
+	insertQuery := `
+        INSERT INTO rate_limit_buckets (key, tokens, updated_at, full_at)
+        VALUES ($1, $2, $3, $3)
+        ON CONFLICT (key) DO NOTHING
+    `
+	if _, err := tx.ExecContext(ctx, insertQuery, key, float64(limit.Burst), now); err != nil {
+		return ratelimit.Result{}, pgerr.Wrap("rateLimit.Take", err)
+	}
+
+	var b ratelimit.Bucket
+	selectQuery := `
+        SELECT tokens, updated_at
+        FROM rate_limit_buckets
+        WHERE key = $1
+        FOR UPDATE
+    `
+	if err := tx.GetContext(ctx, &b, selectQuery, key); err != nil {
+		return ratelimit.Result{}, pgerr.Wrap("rateLimit.Take", err)
+	}
+
+	result := b.Take(limit, now)
+
+	updateQuery := `
+        UPDATE rate_limit_buckets
+        SET tokens = $2, updated_at = $3, full_at = $4
+        WHERE key = $1
+    `
+	if _, err := tx.ExecContext(ctx, updateQuery, key, b.Tokens, b.UpdatedAt, now.Add(result.Reset)); err != nil {
+		return ratelimit.Result{}, pgerr.Wrap("rateLimit.Take", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ratelimit.Result{}, pgerr.Wrap("rateLimit.Take", err)
+	}
+
+	return result, nil
+}
+
+// Sweep implements ratelimit.Sweeper.
+// A bucket deleted while a request is taking a token from it is simply created again as full.
+func (s *rateLimitStore) Sweep(ctx context.Context, now time.Time) error {
+	if s.db == nil {
+		return errors.NewError(errors.NotConnectedError, "")
+	}
+
+	query := `DELETE FROM rate_limit_buckets WHERE full_at <= $1`
+	if _, err := s.db.ExecContext(ctx, query, now); err != nil {
+		return pgerr.Wrap("rateLimit.Sweep", err)
+	}
+	return nil
+}
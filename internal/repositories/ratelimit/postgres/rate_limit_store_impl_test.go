@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"player_management_system/internal/pkg/ratelimit"
+)
+
+func TestRateLimitStore_Take(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	store := NewRateLimitStore(sqlx.NewDb(db, "sqlmock"))
+
+	limit := ratelimit.Limit{Rate: 1, Burst: 5}
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO rate_limit_buckets (key, tokens, updated_at, full_at) VALUES ($1, $2, $3, $3) ON CONFLICT (key) DO NOTHING`)).
+		WithArgs("read:key:a", 5.0, now).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM rate_limit_buckets WHERE key = $1 FOR UPDATE`)).
+		WithArgs("read:key:a").
+		WillReturnRows(sqlmock.NewRows([]string{"tokens", "updated_at"}).AddRow(0.5, now.Add(-time.Second)))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE rate_limit_buckets SET tokens = $2, updated_at = $3, full_at = $4 WHERE key = $1`)).
+		WithArgs("read:key:a", 0.5, now, now.Add(4500*time.Millisecond)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	result, err := store.Take(context.Background(), "read:key:a", limit, now)
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRateLimitStore_Sweep(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	store := NewRateLimitStore(sqlx.NewDb(db, "sqlmock")).(ratelimit.Sweeper)
+	now := time.Now()
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM rate_limit_buckets WHERE full_at <= $1`)).
+		WithArgs(now).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	assert.NoError(t, store.Sweep(context.Background(), now))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
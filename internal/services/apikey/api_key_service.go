@@ -0,0 +1,137 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"player_management_system/internal/domains/apikeys"
+	"player_management_system/internal/pkg/auth"
+	customErrors "player_management_system/internal/pkg/errors"
+	apiKeyRepo "player_management_system/internal/repositories/apikey"
+)
+
+// APIKeyService defines the interface for API key operations.
+type APIKeyService interface {
+	IssueAPIKey(ctx context.Context, name, owner string, scopes []string, expiresAt *time.Time) (*apikey.APIKey, string, error)
+	GetAPIKeys(ctx context.Context) ([]*apikey.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+	RotateAPIKey(ctx context.Context, id uuid.UUID, grace time.Duration) (*apikey.APIKey, string, error)
+	Authenticate(ctx context.Context, plaintext string) (*auth.Principal, error)
+}
+
+const (
+	// lastUsedInterval limits how often the last-used time of a key is written.
+	lastUsedInterval = time.Minute
+	// MaxRotationGrace is the longest time a rotated key keeps working.
+	MaxRotationGrace = 7 * 24 * time.Hour
+)
+
+// apiKeyService restricts key management to admins; Authenticate is open to everyone.
+type apiKeyService struct {
+	repo apiKeyRepo.APIKeyRepository
+	now  func() time.Time
+}
+
+// NewAPIKeyService creates a new APIKeyService instance.
+func NewAPIKeyService(repo apiKeyRepo.APIKeyRepository) APIKeyService {
+	return &apiKeyService{repo: repo, now: time.Now}
+}
+
+// IssueAPIKey creates a new API key and returns it together with the plaintext key,
+// which cannot be retrieved again.
+func (s *apiKeyService) IssueAPIKey(ctx context.Context, name, owner string, scopes []string, expiresAt *time.Time) (*apikey.APIKey, string, error) {
+	if err := auth.RequireAdmin(ctx); err != nil {
+		return nil, "", err
+	}
+
+	k, plaintext, err := apikey.NewAPIKey(name, owner, scopes, expiresAt)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.repo.CreateAPIKey(ctx, k); err != nil {
+		return nil, "", err
+	}
+
+	return k, plaintext, nil
+}
+
+// GetAPIKeys retrieves all API keys.
+func (s *apiKeyService) GetAPIKeys(ctx context.Context) ([]*apikey.APIKey, error) {
+	if err := auth.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAPIKeys(ctx)
+}
+
+// RevokeAPIKey revokes an API key immediately.
+func (s *apiKeyService) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	if err := auth.RequireAdmin(ctx); err != nil {
+		return err
+	}
+	return s.repo.RevokeAPIKey(ctx, id, s.now())
+}
+
+// RotateAPIKey issues a replacement for an API key with the same name, owner, scopes and expiry.
+// The old key keeps working for the grace period so that the partner can switch over.
+func (s *apiKeyService) RotateAPIKey(ctx context.Context, id uuid.UUID, grace time.Duration) (*apikey.APIKey, string, error) {
+	if err := auth.RequireAdmin(ctx); err != nil {
+		return nil, "", err
+	}
+	if grace < 0 || grace > MaxRotationGrace {
+		return nil, "", customErrors.NewFieldError("grace_seconds", customErrors.MsgBetween, 0, int(MaxRotationGrace.Seconds()))
+	}
+
+	old, err := s.repo.GetAPIKeyByID(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	now := s.now()
+	if !old.Active(now) {
+		return nil, "", customErrors.NewError(customErrors.InvalidArgumentError, "api key is expired or revoked")
+	}
+
+	k, plaintext, err := apikey.NewAPIKey(old.Name, old.Owner, old.Scopes, old.ExpiresAt)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.repo.RotateAPIKey(ctx, old.ID, k, now.Add(grace)); err != nil {
+		return nil, "", err
+	}
+
+	return k, plaintext, nil
+}
+
+// Authenticate resolves a plaintext API key to a principal acting on behalf of the key owner.
+func (s *apiKeyService) Authenticate(ctx context.Context, plaintext string) (*auth.Principal, error) {
+	k, err := s.repo.GetAPIKeyByHash(ctx, apikey.HashKey(plaintext))
+	if err != nil {
+		var customErr *customErrors.Error
+		if errors.As(err, &customErr) && customErr.Code == customErrors.NotFoundError {
+			return nil, customErrors.NewError(customErrors.UnauthenticatedError, "invalid API key")
+		}
+		return nil, err
+	}
+
+	now := s.now()
+	if !k.Active(now) {
+		return nil, customErrors.NewError(customErrors.UnauthenticatedError, "API key is expired or revoked")
+	}
+
+	if k.LastUsedAt == nil || now.Sub(*k.LastUsedAt) >= lastUsedInterval {
+		// 마지막 사용 시각 기록 실패로 요청을 거부하지는 않음
+		if err := s.repo.UpdateLastUsed(ctx, k.ID, now); err != nil {
+			slog.WarnContext(ctx, "failed to update last use of api key", "api_key_id", k.ID, "error", err)
+		}
+	}
+
+	return &auth.Principal{
+		Subject:  k.Owner,
+		Name:     k.Name,
+		APIKeyID: k.ID.String(),
+		Scopes:   k.Scopes,
+	}, nil
+}
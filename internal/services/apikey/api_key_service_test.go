@@ -0,0 +1,181 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	apiKeyDom "player_management_system/internal/domains/apikeys"
+	"player_management_system/internal/pkg/auth"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+// MockAPIKeyRepository is a mock implementation of apiKeyRepo.APIKeyRepository
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepository) CreateAPIKey(ctx context.Context, k *apiKeyDom.APIKey) error {
+	args := m.Called(ctx, k)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) GetAPIKeyByID(ctx context.Context, id uuid.UUID) (*apiKeyDom.APIKey, error) {
+	args := m.Called(ctx, id)
+	k, _ := args.Get(0).(*apiKeyDom.APIKey)
+	return k, args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (*apiKeyDom.APIKey, error) {
+	args := m.Called(ctx, hash)
+	k, _ := args.Get(0).(*apiKeyDom.APIKey)
+	return k, args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) GetAPIKeys(ctx context.Context) ([]*apiKeyDom.APIKey, error) {
+	args := m.Called(ctx)
+	keys, _ := args.Get(0).([]*apiKeyDom.APIKey)
+	return keys, args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) RevokeAPIKey(ctx context.Context, id uuid.UUID, at time.Time) error {
+	args := m.Called(ctx, id, at)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) RotateAPIKey(ctx context.Context, id uuid.UUID, replacement *apiKeyDom.APIKey, revokeAt time.Time) error {
+	args := m.Called(ctx, id, replacement, revokeAt)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	args := m.Called(ctx, id, at)
+	return args.Error(0)
+}
+
+func adminContext() context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "admin", Roles: []string{auth.RoleAdmin}})
+}
+
+func assertErrorCode(t *testing.T, code customErrors.ErrorCode, err error) {
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, code, customErr.Code)
+	}
+}
+
+// newTestService returns a service whose clock is frozen at now.
+func newTestService(repo *MockAPIKeyRepository, now time.Time) APIKeyService {
+	return &apiKeyService{repo: repo, now: func() time.Time { return now }}
+}
+
+func TestIssueAPIKey(t *testing.T) {
+	t.Run("admin", func(t *testing.T) {
+		mockRepo := new(MockAPIKeyRepository)
+		service := NewAPIKeyService(mockRepo)
+		mockRepo.On("CreateAPIKey", mock.Anything, mock.AnythingOfType("*apikey.APIKey")).Return(nil)
+
+		k, plaintext, err := service.IssueAPIKey(adminContext(), "partner", "acme", []string{apiKeyDom.ScopePlayersRead}, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, apiKeyDom.HashKey(plaintext), k.Hash)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("editor is forbidden", func(t *testing.T) {
+		service := NewAPIKeyService(new(MockAPIKeyRepository))
+		ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "e", Roles: []string{auth.RoleEditor}})
+
+		_, _, err := service.IssueAPIKey(ctx, "partner", "acme", []string{apiKeyDom.ScopePlayersRead}, nil)
+
+		assertErrorCode(t, customErrors.ForbiddenError, err)
+	})
+}
+
+func TestRotateAPIKey(t *testing.T) {
+	now := time.Now()
+	mockRepo := new(MockAPIKeyRepository)
+	service := newTestService(mockRepo, now)
+
+	old := &apiKeyDom.APIKey{ID: uuid.New(), Name: "partner", Owner: "acme", Scopes: []string{apiKeyDom.ScopePlayersWrite}}
+	mockRepo.On("GetAPIKeyByID", mock.Anything, old.ID).Return(old, nil)
+	mockRepo.On("RotateAPIKey", mock.Anything, old.ID, mock.AnythingOfType("*apikey.APIKey"), now.Add(time.Hour)).Return(nil)
+
+	k, plaintext, err := service.RotateAPIKey(adminContext(), old.ID, time.Hour)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, old.ID, k.ID)
+	assert.Equal(t, old.Owner, k.Owner)
+	assert.Equal(t, old.Scopes, k.Scopes)
+	assert.NotEmpty(t, plaintext)
+	mockRepo.AssertExpectations(t)
+
+	_, _, err = service.RotateAPIKey(adminContext(), old.ID, 30*24*time.Hour)
+	assertErrorCode(t, customErrors.InvalidArgumentError, err)
+}
+
+func TestAuthenticate(t *testing.T) {
+	now := time.Now()
+
+	t.Run("valid key", func(t *testing.T) {
+		mockRepo := new(MockAPIKeyRepository)
+		service := newTestService(mockRepo, now)
+
+		k, plaintext, err := apiKeyDom.NewAPIKey("partner", "acme", []string{apiKeyDom.ScopePlayersRead}, nil)
+		assert.NoError(t, err)
+		mockRepo.On("GetAPIKeyByHash", mock.Anything, k.Hash).Return(k, nil)
+		mockRepo.On("UpdateLastUsed", mock.Anything, k.ID, now).Return(nil)
+
+		p, err := service.Authenticate(context.Background(), plaintext)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "acme", p.Subject)
+		assert.Equal(t, k.ID.String(), p.APIKeyID)
+		assert.True(t, p.Can(auth.ActionRead, ""))
+		assert.False(t, p.Can(auth.ActionCreate, ""))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("recently used key is not touched", func(t *testing.T) {
+		mockRepo := new(MockAPIKeyRepository)
+		service := newTestService(mockRepo, now)
+
+		recent := now.Add(-10 * time.Second)
+		k := &apiKeyDom.APIKey{ID: uuid.New(), Owner: "acme", LastUsedAt: &recent}
+		mockRepo.On("GetAPIKeyByHash", mock.Anything, apiKeyDom.HashKey("pms_x")).Return(k, nil)
+
+		_, err := service.Authenticate(context.Background(), "pms_x")
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "UpdateLastUsed", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("revoked key", func(t *testing.T) {
+		mockRepo := new(MockAPIKeyRepository)
+		service := newTestService(mockRepo, now)
+
+		revoked := now.Add(-time.Minute)
+		k := &apiKeyDom.APIKey{ID: uuid.New(), Owner: "acme", RevokedAt: &revoked}
+		mockRepo.On("GetAPIKeyByHash", mock.Anything, apiKeyDom.HashKey("pms_x")).Return(k, nil)
+
+		_, err := service.Authenticate(context.Background(), "pms_x")
+
+		assertErrorCode(t, customErrors.UnauthenticatedError, err)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		mockRepo := new(MockAPIKeyRepository)
+		service := newTestService(mockRepo, now)
+
+		mockRepo.On("GetAPIKeyByHash", mock.Anything, apiKeyDom.HashKey("pms_x")).
+			Return(nil, customErrors.NewError(customErrors.NotFoundError, "api key not found"))
+
+		_, err := service.Authenticate(context.Background(), "pms_x")
+
+		assertErrorCode(t, customErrors.UnauthenticatedError, err)
+	})
+}
@@ -0,0 +1,159 @@
+// Package instrumented decorates the player service with tracing, so that the service stays free of
+// instrumentation.
+package instrumented
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/errors"
+	playerService "player_management_system/internal/services/player"
+)
+
+// tracerName names the tracer of the player service.
+const tracerName = "player_management_system/internal/services/player"
+
+type tracedPlayerService struct {
+	next   playerService.PlayerService
+	tracer trace.Tracer
+}
+
+// NewPlayerService wraps next so that every call is recorded as a span named PlayerService.<method>.
+func NewPlayerService(next playerService.PlayerService, tp trace.TracerProvider) playerService.PlayerService {
+	return &tracedPlayerService{next: next, tracer: tp.Tracer(tracerName)}
+}
+
+// start starts the span of a call. The returned function ends it with the code of the error the call returned.
+func (s *tracedPlayerService) start(ctx context.Context, method string) (context.Context, func(error)) {
+	ctx, span := s.tracer.Start(ctx, "PlayerService."+method)
+	return ctx, func(err error) {
+		if err != nil {
+			// 오류 문장에는 내부 주소 등이 담길 수 있으므로 코드만 기록함
+			code := errors.CodeOf(err)
+			span.RecordError(code)
+			// 클라이언트 오류는 정상적인 처리 결과이므로 서버 오류만 실패로 표시함
+			if errors.GetHTTPStatusCode(err) >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, string(code))
+			}
+		}
+		span.End()
+	}
+}
+
+// CreatePlayer implements playerService.PlayerService.
+func (s *tracedPlayerService) CreatePlayer(ctx context.Context, p *player.Player) (err error) {
+	ctx, end := s.start(ctx, "CreatePlayer")
+	defer func() { end(err) }()
+	return s.next.CreatePlayer(ctx, p)
+}
+
+// GetPlayerByID implements playerService.PlayerService.
+func (s *tracedPlayerService) GetPlayerByID(ctx context.Context, id uuid.UUID) (_ *player.Player, err error) {
+	ctx, end := s.start(ctx, "GetPlayerByID")
+	defer func() { end(err) }()
+	return s.next.GetPlayerByID(ctx, id)
+}
+
+// UpdatePlayer implements playerService.PlayerService.
+func (s *tracedPlayerService) UpdatePlayer(ctx context.Context, p *player.Player) (err error) {
+	ctx, end := s.start(ctx, "UpdatePlayer")
+	defer func() { end(err) }()
+	return s.next.UpdatePlayer(ctx, p)
+}
+
+// DeletePlayer implements playerService.PlayerService.
+func (s *tracedPlayerService) DeletePlayer(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, end := s.start(ctx, "DeletePlayer")
+	defer func() { end(err) }()
+	return s.next.DeletePlayer(ctx, id)
+}
+
+// GetPlayers implements playerService.PlayerService.
+func (s *tracedPlayerService) GetPlayers(ctx context.Context) (_ []*player.Player, err error) {
+	ctx, end := s.start(ctx, "GetPlayers")
+	defer func() { end(err) }()
+	return s.next.GetPlayers(ctx)
+}
+
+// GetPlayersWithPagination implements playerService.PlayerService.
+func (s *tracedPlayerService) GetPlayersWithPagination(ctx context.Context, page, pageSize int) (_ []*player.Player, err error) {
+	ctx, end := s.start(ctx, "GetPlayersWithPagination")
+	defer func() { end(err) }()
+	return s.next.GetPlayersWithPagination(ctx, page, pageSize)
+}
+
+// GetPlayerByIDWithOptions implements playerService.PlayerService.
+func (s *tracedPlayerService) GetPlayerByIDWithOptions(ctx context.Context, id uuid.UUID, opts player.ReadOptions) (_ *player.Player, err error) {
+	ctx, end := s.start(ctx, "GetPlayerByIDWithOptions")
+	defer func() { end(err) }()
+	return s.next.GetPlayerByIDWithOptions(ctx, id, opts)
+}
+
+// GetPlayersWithOptions implements playerService.PlayerService.
+func (s *tracedPlayerService) GetPlayersWithOptions(ctx context.Context, page, pageSize int, filter player.PlayerFilter, opts player.ReadOptions) (_ []*player.Player, err error) {
+	ctx, end := s.start(ctx, "GetPlayersWithOptions")
+	defer func() { end(err) }()
+	return s.next.GetPlayersWithOptions(ctx, page, pageSize, filter, opts)
+}
+
+// ExportPlayers implements playerService.PlayerService. The span includes the time fn takes to write
+// the players out.
+func (s *tracedPlayerService) ExportPlayers(ctx context.Context, filter player.PlayerFilter, opts player.ReadOptions, fn func(*player.Player) error) (err error) {
+	ctx, end := s.start(ctx, "ExportPlayers")
+	defer func() { end(err) }()
+	return s.next.ExportPlayers(ctx, filter, opts, fn)
+}
+
+// CreatePlayers implements playerService.PlayerService.
+func (s *tracedPlayerService) CreatePlayers(ctx context.Context, players []*player.Player, mode player.BatchMode, upsert bool) (_ []player.BatchItemStatus, err error) {
+	ctx, end := s.start(ctx, "CreatePlayers")
+	defer func() { end(err) }()
+	return s.next.CreatePlayers(ctx, players, mode, upsert)
+}
+
+// GetPlayerProfile implements playerService.PlayerService.
+func (s *tracedPlayerService) GetPlayerProfile(ctx context.Context, id uuid.UUID) (_ *player.PlayerProfile, err error) {
+	ctx, end := s.start(ctx, "GetPlayerProfile")
+	defer func() { end(err) }()
+	return s.next.GetPlayerProfile(ctx, id)
+}
+
+// FindDuplicates implements playerService.PlayerService.
+func (s *tracedPlayerService) FindDuplicates(ctx context.Context, sport string, minScore float64, limit int) (_ []*player.DuplicateCandidate, err error) {
+	ctx, end := s.start(ctx, "FindDuplicates")
+	defer func() { end(err) }()
+	return s.next.FindDuplicates(ctx, sport, minScore, limit)
+}
+
+// MergePlayers implements playerService.PlayerService.
+func (s *tracedPlayerService) MergePlayers(ctx context.Context, survivorID, mergedID uuid.UUID) (_ *player.Player, err error) {
+	ctx, end := s.start(ctx, "MergePlayers")
+	defer func() { end(err) }()
+	return s.next.MergePlayers(ctx, survivorID, mergedID)
+}
+
+// GetPlayerRedirect implements playerService.PlayerService.
+func (s *tracedPlayerService) GetPlayerRedirect(ctx context.Context, id uuid.UUID) (_ uuid.UUID, err error) {
+	ctx, end := s.start(ctx, "GetPlayerRedirect")
+	defer func() { end(err) }()
+	return s.next.GetPlayerRedirect(ctx, id)
+}
+
+// GetPlayerByExternalID implements playerService.PlayerService.
+func (s *tracedPlayerService) GetPlayerByExternalID(ctx context.Context, namespace, value string, opts player.ReadOptions) (_ *player.Player, err error) {
+	ctx, end := s.start(ctx, "GetPlayerByExternalID")
+	defer func() { end(err) }()
+	return s.next.GetPlayerByExternalID(ctx, namespace, value, opts)
+}
+
+// GetPlayersByExternalIDs implements playerService.PlayerService.
+func (s *tracedPlayerService) GetPlayersByExternalIDs(ctx context.Context, externalIDs []string) (_ []*player.Player, err error) {
+	ctx, end := s.start(ctx, "GetPlayersByExternalIDs")
+	defer func() { end(err) }()
+	return s.next.GetPlayersByExternalIDs(ctx, externalIDs)
+}
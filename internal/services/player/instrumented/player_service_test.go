@@ -0,0 +1,79 @@
+package instrumented
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/errors"
+	playerService "player_management_system/internal/services/player"
+)
+
+// stubService implements the methods called in the tests; the others panic.
+type stubService struct {
+	playerService.PlayerService
+	err error
+	// ctx is the context the last call received.
+	ctx context.Context
+}
+
+func (s *stubService) GetPlayerByID(ctx context.Context, id uuid.UUID) (*player.Player, error) {
+	s.ctx = ctx
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &player.Player{ID: id}, nil
+}
+
+func TestPlayerService_RecordsSpans(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status codes.Code
+	}{
+		{name: "success", status: codes.Unset},
+		// 클라이언트 오류는 실패로 표시하지 않음
+		{name: "not found", err: errors.NewError(errors.NotFoundError, "player not found"), status: codes.Unset},
+		{name: "database error", err: errors.NewError(errors.DatabaseError, "connection reset"), status: codes.Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+			stub := &stubService{err: tt.err}
+			service := NewPlayerService(stub, tp)
+
+			ctx, parent := tp.Tracer("test").Start(context.Background(), "GET /players/:id")
+			_, err := service.GetPlayerByID(ctx, uuid.New())
+			parent.End()
+
+			assert.Equal(t, tt.err, err)
+			spans := exporter.GetSpans()
+			if assert.Len(t, spans, 2) {
+				span := spans[0]
+				assert.Equal(t, "PlayerService.GetPlayerByID", span.Name)
+				assert.Equal(t, parent.SpanContext().SpanID(), span.Parent.SpanID())
+				assert.Equal(t, tt.status, span.Status.Code)
+				// 저장소의 문장은 서비스 구간 아래에 기록됨
+				assert.Equal(t, span.SpanContext.SpanID(), trace.SpanContextFromContext(stub.ctx).SpanID())
+				if tt.err != nil {
+					assert.Len(t, span.Events, 1)
+					// 오류 문장은 기록하지 않음
+					assert.NotContains(t, fmt.Sprint(span.Status, span.Events), "connection reset")
+				}
+				if tt.status == codes.Error {
+					assert.Equal(t, "DatabaseError", span.Status.Description)
+				}
+			}
+		})
+	}
+}
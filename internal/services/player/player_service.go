@@ -2,10 +2,19 @@ package player
 
 import (
 	"context"
+	"errors"
+	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
 	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/auth"
+	"player_management_system/internal/pkg/blob"
+	customErrors "player_management_system/internal/pkg/errors"
 	playerRepo "player_management_system/internal/repositories/player" // 수정된 부분
+	"player_management_system/internal/services/profileimage"
 )
 
 // PlayerService defines the interface for player-related operations.
@@ -16,43 +25,307 @@ type PlayerService interface {
 	DeletePlayer(ctx context.Context, id uuid.UUID) error
 	GetPlayers(ctx context.Context) ([]*player.Player, error)
 	GetPlayersWithPagination(ctx context.Context, page, pageSize int) ([]*player.Player, error)
+	GetPlayerByIDWithOptions(ctx context.Context, id uuid.UUID, opts player.ReadOptions) (*player.Player, error)
+	GetPlayersWithOptions(ctx context.Context, page, pageSize int, filter player.PlayerFilter, opts player.ReadOptions) ([]*player.Player, error)
+	ExportPlayers(ctx context.Context, filter player.PlayerFilter, opts player.ReadOptions, fn func(*player.Player) error) error
+	CreatePlayers(ctx context.Context, players []*player.Player, mode player.BatchMode, upsert bool) ([]player.BatchItemStatus, error)
+	GetPlayerProfile(ctx context.Context, id uuid.UUID) (*player.PlayerProfile, error)
+	FindDuplicates(ctx context.Context, sport string, minScore float64, limit int) ([]*player.DuplicateCandidate, error)
+	MergePlayers(ctx context.Context, survivorID, mergedID uuid.UUID) (*player.Player, error)
+	GetPlayerRedirect(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	GetPlayerByExternalID(ctx context.Context, namespace, value string, opts player.ReadOptions) (*player.Player, error)
+	GetPlayersByExternalIDs(ctx context.Context, externalIDs []string) ([]*player.Player, error)
 }
 
+const (
+	// profileTimeout bounds the total time spent loading a player profile.
+	profileTimeout = 3 * time.Second
+	// profileMediaLimit is the number of recent media items in a profile.
+	profileMediaLimit = 10
+)
+
+// playerService enforces the authorization policy of internal/pkg/auth on every operation,
+// so that every transport gets the same rules.
 type playerService struct {
-	repo playerRepo.PlayerRepository // 수정된 부분 (인터페이스 타입 사용)
+	repo   playerRepo.PlayerRepository // 수정된 부분 (인터페이스 타입 사용)
+	images blob.Store
+}
+
+// PlayerServiceOption configures a PlayerService.
+type PlayerServiceOption func(*playerService)
+
+// WithImageStore lets the service delete the stored profile images that players no longer use.
+func WithImageStore(store blob.Store) PlayerServiceOption {
+	return func(s *playerService) {
+		s.images = store
+	}
 }
 
 // NewPlayerService creates a new PlayerService instance.
-func NewPlayerService(repo playerRepo.PlayerRepository) PlayerService {
-	return &playerService{repo: repo}
+func NewPlayerService(repo playerRepo.PlayerRepository, opts ...PlayerServiceOption) PlayerService {
+	s := &playerService{repo: repo}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // CreatePlayer creates a new player.
 func (s *playerService) CreatePlayer(ctx context.Context, p *player.Player) error {
+	if err := auth.Authorize(ctx, auth.ActionCreate, p.Team); err != nil {
+		return err
+	}
 	return s.repo.CreatePlayer(ctx, p)
 }
 
 // GetPlayerByID retrieves a player by their ID.
 func (s *playerService) GetPlayerByID(ctx context.Context, id uuid.UUID) (*player.Player, error) {
+	if err := auth.Authorize(ctx, auth.ActionRead, ""); err != nil {
+		return nil, err
+	}
 	return s.repo.GetPlayerByID(ctx, id)
 }
 
 // UpdatePlayer updates an existing player.
+// Team managers may neither update players of another team nor move a player to another team.
 func (s *playerService) UpdatePlayer(ctx context.Context, p *player.Player) error {
+	if err := auth.Authorize(ctx, auth.ActionUpdate, p.Team); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.GetPlayerByID(ctx, p.ID)
+	if err != nil {
+		return err
+	}
+	if err := auth.Authorize(ctx, auth.ActionUpdate, existing.Team); err != nil {
+		return err
+	}
+
 	return s.repo.UpdatePlayer(ctx, p)
 }
 
 // DeletePlayer deletes a player by their ID.
 func (s *playerService) DeletePlayer(ctx context.Context, id uuid.UUID) error {
+	if err := auth.Authorize(ctx, auth.ActionDelete, ""); err != nil {
+		return err
+	}
 	return s.repo.DeletePlayer(ctx, id)
 }
 
 // GetPlayers retrieves all players.
 func (s *playerService) GetPlayers(ctx context.Context) ([]*player.Player, error) {
+	if err := auth.Authorize(ctx, auth.ActionRead, ""); err != nil {
+		return nil, err
+	}
 	return s.repo.GetPlayers(ctx)
 }
 
 // GetPlayersWithPagination retrieves players with pagination.
 func (s *playerService) GetPlayersWithPagination(ctx context.Context, page, pageSize int) ([]*player.Player, error) {
+	if err := auth.Authorize(ctx, auth.ActionRead, ""); err != nil {
+		return nil, err
+	}
 	return s.repo.GetPlayersWithPagination(ctx, page, pageSize)
 }
+
+// GetPlayerByIDWithOptions retrieves a player by their ID with a sparse fieldset and embedded relations.
+func (s *playerService) GetPlayerByIDWithOptions(ctx context.Context, id uuid.UUID, opts player.ReadOptions) (*player.Player, error) {
+	if err := auth.Authorize(ctx, auth.ActionRead, ""); err != nil {
+		return nil, err
+	}
+	return s.repo.GetPlayerByIDWithOptions(ctx, id, opts)
+}
+
+// GetPlayersWithOptions retrieves the players matching the filter with pagination, a sparse fieldset and embedded relations.
+func (s *playerService) GetPlayersWithOptions(ctx context.Context, page, pageSize int, filter player.PlayerFilter, opts player.ReadOptions) ([]*player.Player, error) {
+	if err := auth.Authorize(ctx, auth.ActionRead, ""); err != nil {
+		return nil, err
+	}
+	return s.repo.GetPlayersWithOptions(ctx, page, pageSize, filter, opts)
+}
+
+// ExportPlayers calls fn for every player matching the filter without loading them all into memory.
+func (s *playerService) ExportPlayers(ctx context.Context, filter player.PlayerFilter, opts player.ReadOptions, fn func(*player.Player) error) error {
+	if err := auth.Authorize(ctx, auth.ActionRead, ""); err != nil {
+		return err
+	}
+	return s.repo.StreamPlayers(ctx, filter, opts, fn)
+}
+
+// CreatePlayers creates players in bulk and returns the outcome of each player in input order.
+// In atomic mode a conflict rolls back the whole batch; in partial mode conflicting players are skipped.
+// With upsert, players are matched on their external ID and existing ones are updated.
+func (s *playerService) CreatePlayers(ctx context.Context, players []*player.Player, mode player.BatchMode, upsert bool) ([]player.BatchItemStatus, error) {
+	if len(players) > player.MaxBatchSize {
+		return nil, customErrors.NewErrorWithArgs(customErrors.InvalidArgumentError, "batch exceeds %d players", player.MaxBatchSize)
+	}
+
+	for _, p := range players {
+		if err := auth.Authorize(ctx, auth.ActionCreate, p.Team); err != nil {
+			return nil, err
+		}
+	}
+
+	if upsert {
+		// 덮어쓸 기존 선수의 팀은 저장소가 잠근 행에서 확인
+		return s.repo.UpsertPlayersByExternalID(ctx, players, auth.AllowedTeams(ctx, auth.ActionUpdate))
+	}
+	return s.repo.CreatePlayers(ctx, players, mode == player.BatchModeAtomic)
+}
+
+// GetPlayerProfile retrieves a player together with the team details, the latest description,
+// the most recent media, the current season stats and the injury status.
+// The queries run concurrently and share a single deadline.
+func (s *playerService) GetPlayerProfile(ctx context.Context, id uuid.UUID) (*player.PlayerProfile, error) {
+	if err := auth.Authorize(ctx, auth.ActionRead, ""); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, profileTimeout)
+	defer cancel()
+
+	profile := &player.PlayerProfile{RecentMedia: []*player.Media{}}
+	g, ctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		p, err := s.repo.GetPlayerByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		profile.Player = p
+
+		// The team is looked up by name, so it has to wait for the player.
+		t, err := s.repo.GetTeamByName(ctx, p.Team)
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+		profile.Team = t
+		return nil
+	})
+
+	g.Go(func() error {
+		d, err := s.repo.GetLatestDescription(ctx, id)
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+		profile.LatestDescription = d
+		return nil
+	})
+
+	g.Go(func() error {
+		m, err := s.repo.GetRecentMedia(ctx, id, profileMediaLimit)
+		if err != nil {
+			return err
+		}
+		profile.RecentMedia = m
+		return nil
+	})
+
+	g.Go(func() error {
+		st, err := s.repo.GetSeasonStats(ctx, id, time.Now().Year())
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+		profile.SeasonStats = st
+		return nil
+	})
+
+	g.Go(func() error {
+		i, err := s.repo.GetCurrentInjury(ctx, id)
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+		profile.Injury = i
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// FindDuplicates returns up to limit pairs of players of the given sport, or of any sport when empty,
+// that score at least minScore as likely duplicates. Aliases take part in the name comparison.
+func (s *playerService) FindDuplicates(ctx context.Context, sport string, minScore float64, limit int) ([]*player.DuplicateCandidate, error) {
+	if err := auth.Authorize(ctx, auth.ActionRead, ""); err != nil {
+		return nil, err
+	}
+
+	opts := player.ReadOptions{
+		Fields: []string{"name", "sport", "team", "birth_date", "created_at"},
+		Expand: []string{player.ExpandAliases},
+	}
+	var players []*player.Player
+	err := s.repo.StreamPlayers(ctx, player.PlayerFilter{Sport: sport}, opts, func(p *player.Player) error {
+		players = append(players, p)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := player.FindDuplicates(players, minScore)
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates, nil
+}
+
+// MergePlayers folds the merged player into the survivor and returns the survivor.
+// Merging deletes a player record, so it is limited to those who may delete players.
+// The merged player's stored profile image moves to the survivor when the survivor has none,
+// and is deleted otherwise.
+func (s *playerService) MergePlayers(ctx context.Context, survivorID, mergedID uuid.UUID) (*player.Player, error) {
+	if err := auth.Authorize(ctx, auth.ActionDelete, ""); err != nil {
+		return nil, err
+	}
+	if survivorID == mergedID {
+		return nil, customErrors.NewError(customErrors.InvalidArgumentError, "cannot merge a player into itself")
+	}
+
+	discardedURL, err := s.repo.MergePlayers(ctx, survivorID, mergedID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.images != nil && discardedURL != "" {
+		// 병합은 이미 끝났으므로 이미지 삭제 실패는 기록만 함
+		if err := profileimage.DeleteStoredImage(ctx, s.images, mergedID, discardedURL); err != nil {
+			slog.ErrorContext(ctx, "failed to delete profile image of merged player", "player_id", mergedID, "error", err)
+		}
+	}
+	return s.repo.GetPlayerByIDWithOptions(ctx, survivorID, player.ReadOptions{})
+}
+
+// GetPlayerRedirect returns the ID of the player a merged player was folded into.
+func (s *playerService) GetPlayerRedirect(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	if err := auth.Authorize(ctx, auth.ActionRead, ""); err != nil {
+		return uuid.Nil, err
+	}
+	return s.repo.GetPlayerRedirect(ctx, id)
+}
+
+// GetPlayerByExternalID retrieves the player linked to an identifier of another system.
+func (s *playerService) GetPlayerByExternalID(ctx context.Context, namespace, value string, opts player.ReadOptions) (*player.Player, error) {
+	if err := auth.Authorize(ctx, auth.ActionRead, ""); err != nil {
+		return nil, err
+	}
+	return s.repo.GetPlayerByExternalID(ctx, namespace, value, opts)
+}
+
+// GetPlayersByExternalIDs retrieves the players linked to external IDs in the default namespace.
+// IDs that are not linked are skipped.
+func (s *playerService) GetPlayersByExternalIDs(ctx context.Context, externalIDs []string) ([]*player.Player, error) {
+	if err := auth.Authorize(ctx, auth.ActionRead, ""); err != nil {
+		return nil, err
+	}
+	return s.repo.GetPlayersByExternalIDs(ctx, externalIDs)
+}
+
+// isNotFound reports whether err is a NotFound custom error.
+func isNotFound(err error) bool {
+	var customErr *customErrors.Error
+	return errors.As(err, &customErr) && customErr.Code == customErrors.NotFoundError
+}
@@ -2,6 +2,7 @@ package player
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -10,6 +11,9 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	playerDom "player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/auth"
+	"player_management_system/internal/pkg/blob"
+	customErrors "player_management_system/internal/pkg/errors"
 )
 
 // MockPlayerRepository is a mock implementation of the PlayerRepository interface.
@@ -47,6 +51,121 @@ func (m *MockPlayerRepository) GetPlayersWithPagination(ctx context.Context, pag
 	return args.Get(0).([]*playerDom.Player), args.Error(1)
 }
 
+func (m *MockPlayerRepository) GetPlayerByIDWithOptions(ctx context.Context, id uuid.UUID, opts playerDom.ReadOptions) (*playerDom.Player, error) {
+	args := m.Called(ctx, id, opts)
+	return args.Get(0).(*playerDom.Player), args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetPlayersWithOptions(ctx context.Context, page, pageSize int, filter playerDom.PlayerFilter, opts playerDom.ReadOptions) ([]*playerDom.Player, error) {
+	args := m.Called(ctx, page, pageSize, filter, opts)
+	return args.Get(0).([]*playerDom.Player), args.Error(1)
+}
+
+func (m *MockPlayerRepository) StreamPlayers(ctx context.Context, filter playerDom.PlayerFilter, opts playerDom.ReadOptions, fn func(*playerDom.Player) error) error {
+	args := m.Called(ctx, filter, opts, fn)
+	return args.Error(0)
+}
+
+func (m *MockPlayerRepository) MergePlayers(ctx context.Context, survivorID, mergedID uuid.UUID) (string, error) {
+	args := m.Called(ctx, survivorID, mergedID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetPlayerRedirect(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *MockPlayerRepository) UpdateProfileImageURL(ctx context.Context, id uuid.UUID, url string, updatedAt time.Time) error {
+	args := m.Called(ctx, id, url, updatedAt)
+	return args.Error(0)
+}
+
+func (m *MockPlayerRepository) ReplaceProfileImageURL(ctx context.Context, id uuid.UUID, from, to string, updatedAt time.Time) error {
+	args := m.Called(ctx, id, from, to, updatedAt)
+	return args.Error(0)
+}
+
+func (m *MockPlayerRepository) GetRemoteProfileImageURLs(ctx context.Context, after uuid.UUID, limit int) ([]*playerDom.Player, error) {
+	args := m.Called(ctx, after, limit)
+	players, _ := args.Get(0).([]*playerDom.Player)
+	return players, args.Error(1)
+}
+
+func (m *MockPlayerRepository) SaveProfileImageLinkCheck(ctx context.Context, check *playerDom.ProfileImageLinkCheck) error {
+	args := m.Called(ctx, check)
+	return args.Error(0)
+}
+
+func (m *MockPlayerRepository) GetBrokenProfileImageLinks(ctx context.Context) ([]*playerDom.ProfileImageLinkCheck, error) {
+	args := m.Called(ctx)
+	checks, _ := args.Get(0).([]*playerDom.ProfileImageLinkCheck)
+	return checks, args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetPlayerByExternalID(ctx context.Context, namespace, value string, opts playerDom.ReadOptions) (*playerDom.Player, error) {
+	args := m.Called(ctx, namespace, value, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*playerDom.Player), args.Error(1)
+}
+
+func (m *MockPlayerRepository) CreatePlayers(ctx context.Context, players []*playerDom.Player, atomic bool) ([]playerDom.BatchItemStatus, error) {
+	args := m.Called(ctx, players, atomic)
+	return args.Get(0).([]playerDom.BatchItemStatus), args.Error(1)
+}
+
+func (m *MockPlayerRepository) UpsertPlayersByExternalID(ctx context.Context, players []*playerDom.Player, updatableTeams []string) ([]playerDom.BatchItemStatus, error) {
+	args := m.Called(ctx, players, updatableTeams)
+	return args.Get(0).([]playerDom.BatchItemStatus), args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetTeamByName(ctx context.Context, name string) (*playerDom.Team, error) {
+	args := m.Called(ctx, name)
+	return args.Get(0).(*playerDom.Team), args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetLatestDescription(ctx context.Context, playerID uuid.UUID) (*playerDom.PlayerDescription, error) {
+	args := m.Called(ctx, playerID)
+	return args.Get(0).(*playerDom.PlayerDescription), args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetRecentMedia(ctx context.Context, playerID uuid.UUID, limit int) ([]*playerDom.Media, error) {
+	args := m.Called(ctx, playerID, limit)
+	return args.Get(0).([]*playerDom.Media), args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetSeasonStats(ctx context.Context, playerID uuid.UUID, season int) (*playerDom.SeasonStats, error) {
+	args := m.Called(ctx, playerID, season)
+	return args.Get(0).(*playerDom.SeasonStats), args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetCurrentInjury(ctx context.Context, playerID uuid.UUID) (*playerDom.Injury, error) {
+	args := m.Called(ctx, playerID)
+	return args.Get(0).(*playerDom.Injury), args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetPlayersByExternalIDs(ctx context.Context, externalIDs []string) ([]*playerDom.Player, error) {
+	args := m.Called(ctx, externalIDs)
+	return args.Get(0).([]*playerDom.Player), args.Error(1)
+}
+
+func (m *MockPlayerRepository) CountPlayersBySport(ctx context.Context) (map[string]int, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(map[string]int), args.Error(1)
+}
+
+// principalContext returns a context carrying a principal with the given role and team.
+func principalContext(role, team string) context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "test-user", Roles: []string{role}, Team: team})
+}
+
+// adminContext returns a context carrying an admin principal.
+func adminContext() context.Context {
+	return principalContext(auth.RoleAdmin, "")
+}
+
 func TestCreatePlayer(t *testing.T) {
 	mockRepo := new(MockPlayerRepository)
 	service := NewPlayerService(mockRepo)
@@ -63,7 +182,7 @@ func TestCreatePlayer(t *testing.T) {
 
 	mockRepo.On("CreatePlayer", mock.Anything, p).Return(nil)
 
-	err := service.CreatePlayer(context.Background(), p)
+	err := service.CreatePlayer(adminContext(), p)
 	assert.NoError(t, err)
 
 	mockRepo.AssertExpectations(t)
@@ -86,7 +205,7 @@ func TestGetPlayerByID(t *testing.T) {
 
 	mockRepo.On("GetPlayerByID", mock.Anything, playerID).Return(expectedPlayer, nil)
 
-	player, err := service.GetPlayerByID(context.Background(), playerID)
+	player, err := service.GetPlayerByID(adminContext(), playerID)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedPlayer, player)
 
@@ -107,9 +226,10 @@ func TestUpdatePlayer(t *testing.T) {
 		UpdatedAt:       time.Now(),
 	}
 
+	mockRepo.On("GetPlayerByID", mock.Anything, p.ID).Return(p, nil)
 	mockRepo.On("UpdatePlayer", mock.Anything, p).Return(nil)
 
-	err := service.UpdatePlayer(context.Background(), p)
+	err := service.UpdatePlayer(adminContext(), p)
 	assert.NoError(t, err)
 
 	mockRepo.AssertExpectations(t)
@@ -123,7 +243,7 @@ func TestDeletePlayer(t *testing.T) {
 
 	mockRepo.On("DeletePlayer", mock.Anything, playerID).Return(nil)
 
-	err := service.DeletePlayer(context.Background(), playerID)
+	err := service.DeletePlayer(adminContext(), playerID)
 	assert.NoError(t, err)
 
 	mockRepo.AssertExpectations(t)
@@ -156,9 +276,340 @@ func TestGetPlayers(t *testing.T) {
 
 	mockRepo.On("GetPlayers", mock.Anything).Return(expectedPlayers, nil)
 
-	players, err := service.GetPlayers(context.Background())
+	players, err := service.GetPlayers(adminContext())
 	assert.NoError(t, err)
 	assert.Equal(t, expectedPlayers, players)
 
 	mockRepo.AssertExpectations(t)
 }
+
+func TestGetPlayerProfile(t *testing.T) {
+	playerID := uuid.New()
+	notFound := customErrors.NewError(customErrors.NotFoundError, "not found")
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo)
+
+		p := &playerDom.Player{ID: playerID, Name: "김도영", Sport: "야구", Team: "기아"}
+		team := &playerDom.Team{ID: uuid.New(), Name: "기아", Sport: "야구"}
+		description := &playerDom.PlayerDescription{ID: uuid.New(), PlayerID: playerID, Content: "내야수"}
+		media := []*playerDom.Media{{ID: uuid.New(), PlayerID: playerID, Title: "Title"}}
+		stats := &playerDom.SeasonStats{PlayerID: playerID, Season: time.Now().Year(), GamesPlayed: 100}
+
+		mockRepo.On("GetPlayerByID", mock.Anything, playerID).Return(p, nil)
+		mockRepo.On("GetTeamByName", mock.Anything, "기아").Return(team, nil)
+		mockRepo.On("GetLatestDescription", mock.Anything, playerID).Return(description, nil)
+		mockRepo.On("GetRecentMedia", mock.Anything, playerID, 10).Return(media, nil)
+		mockRepo.On("GetSeasonStats", mock.Anything, playerID, time.Now().Year()).Return(stats, nil)
+		mockRepo.On("GetCurrentInjury", mock.Anything, playerID).Return((*playerDom.Injury)(nil), notFound)
+
+		profile, err := service.GetPlayerProfile(adminContext(), playerID)
+		assert.NoError(t, err)
+		assert.Equal(t, p, profile.Player)
+		assert.Equal(t, team, profile.Team)
+		assert.Equal(t, description, profile.LatestDescription)
+		assert.Equal(t, media, profile.RecentMedia)
+		assert.Equal(t, stats, profile.SeasonStats)
+		assert.Nil(t, profile.Injury)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("player not found", func(t *testing.T) {
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo)
+
+		mockRepo.On("GetPlayerByID", mock.Anything, playerID).Return((*playerDom.Player)(nil), notFound)
+		mockRepo.On("GetLatestDescription", mock.Anything, playerID).Return((*playerDom.PlayerDescription)(nil), notFound).Maybe()
+		mockRepo.On("GetRecentMedia", mock.Anything, playerID, 10).Return([]*playerDom.Media{}, nil).Maybe()
+		mockRepo.On("GetSeasonStats", mock.Anything, playerID, mock.Anything).Return((*playerDom.SeasonStats)(nil), notFound).Maybe()
+		mockRepo.On("GetCurrentInjury", mock.Anything, playerID).Return((*playerDom.Injury)(nil), notFound).Maybe()
+
+		profile, err := service.GetPlayerProfile(adminContext(), playerID)
+		assert.Nil(t, profile)
+		assert.Equal(t, notFound, err)
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo)
+		dbErr := customErrors.NewError(customErrors.DatabaseError, "database error")
+
+		p := &playerDom.Player{ID: playerID, Name: "김도영", Sport: "야구", Team: "기아"}
+		mockRepo.On("GetPlayerByID", mock.Anything, playerID).Return(p, nil).Maybe()
+		mockRepo.On("GetTeamByName", mock.Anything, "기아").Return((*playerDom.Team)(nil), notFound).Maybe()
+		mockRepo.On("GetLatestDescription", mock.Anything, playerID).Return((*playerDom.PlayerDescription)(nil), notFound).Maybe()
+		mockRepo.On("GetRecentMedia", mock.Anything, playerID, 10).Return([]*playerDom.Media(nil), dbErr)
+		mockRepo.On("GetSeasonStats", mock.Anything, playerID, mock.Anything).Return((*playerDom.SeasonStats)(nil), notFound).Maybe()
+		mockRepo.On("GetCurrentInjury", mock.Anything, playerID).Return((*playerDom.Injury)(nil), notFound).Maybe()
+
+		profile, err := service.GetPlayerProfile(adminContext(), playerID)
+		assert.Nil(t, profile)
+		assert.Equal(t, dbErr, err)
+	})
+}
+
+func TestCreatePlayers(t *testing.T) {
+	players := []*playerDom.Player{
+		{ID: uuid.New(), Name: "Player 1", Sport: "Football", Team: "Team A", ExternalID: "ext-1"},
+		{ID: uuid.New(), Name: "Player 2", Sport: "Football", Team: "Team A", ExternalID: "ext-2"},
+	}
+
+	t.Run("atomic insert", func(t *testing.T) {
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo)
+
+		statuses := []playerDom.BatchItemStatus{playerDom.BatchItemCreated, playerDom.BatchItemCreated}
+		mockRepo.On("CreatePlayers", mock.Anything, players, true).Return(statuses, nil)
+
+		result, err := service.CreatePlayers(adminContext(), players, playerDom.BatchModeAtomic, false)
+		assert.NoError(t, err)
+		assert.Equal(t, statuses, result)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("upsert", func(t *testing.T) {
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo)
+
+		statuses := []playerDom.BatchItemStatus{playerDom.BatchItemUpdated, playerDom.BatchItemCreated}
+		mockRepo.On("UpsertPlayersByExternalID", mock.Anything, players, []string(nil)).Return(statuses, nil)
+
+		result, err := service.CreatePlayers(adminContext(), players, playerDom.BatchModePartial, true)
+		assert.NoError(t, err)
+		assert.Equal(t, statuses, result)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("too many players", func(t *testing.T) {
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo)
+
+		_, err := service.CreatePlayers(adminContext(), make([]*playerDom.Player, playerDom.MaxBatchSize+1), playerDom.BatchModeAtomic, false)
+
+		var customErr *customErrors.Error
+		if assert.ErrorAs(t, err, &customErr) {
+			assert.Equal(t, customErrors.InvalidArgumentError, customErr.Code)
+		}
+		mockRepo.AssertNotCalled(t, "CreatePlayers", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func assertErrorCode(t *testing.T, code customErrors.ErrorCode, err error) {
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, code, customErr.Code)
+	}
+}
+
+func TestAuthorization(t *testing.T) {
+	kia := &playerDom.Player{ID: uuid.New(), Name: "김도영", Sport: "야구", Team: "기아"}
+	lg := &playerDom.Player{ID: uuid.New(), Name: "오지환", Sport: "야구", Team: "LG"}
+
+	t.Run("no principal", func(t *testing.T) {
+		service := NewPlayerService(new(MockPlayerRepository))
+
+		_, err := service.GetPlayerByID(context.Background(), kia.ID)
+		assertErrorCode(t, customErrors.UnauthenticatedError, err)
+	})
+
+	t.Run("viewer reads but cannot create", func(t *testing.T) {
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo)
+		ctx := principalContext(auth.RoleViewer, "")
+
+		mockRepo.On("GetPlayerByID", mock.Anything, kia.ID).Return(kia, nil)
+
+		_, err := service.GetPlayerByID(ctx, kia.ID)
+		assert.NoError(t, err)
+
+		err = service.CreatePlayer(ctx, kia)
+		assertErrorCode(t, customErrors.ForbiddenError, err)
+		mockRepo.AssertNotCalled(t, "CreatePlayer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("team manager creates on own team only", func(t *testing.T) {
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo)
+		ctx := principalContext(auth.RoleTeamManager, "기아")
+
+		mockRepo.On("CreatePlayer", mock.Anything, kia).Return(nil)
+
+		assert.NoError(t, service.CreatePlayer(ctx, kia))
+		assertErrorCode(t, customErrors.ForbiddenError, service.CreatePlayer(ctx, lg))
+	})
+
+	t.Run("team manager cannot move a player from another team", func(t *testing.T) {
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo)
+		ctx := principalContext(auth.RoleTeamManager, "기아")
+
+		moved := *lg
+		moved.Team = "기아"
+		mockRepo.On("GetPlayerByID", mock.Anything, lg.ID).Return(lg, nil)
+
+		err := service.UpdatePlayer(ctx, &moved)
+		assertErrorCode(t, customErrors.ForbiddenError, err)
+		mockRepo.AssertNotCalled(t, "UpdatePlayer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("editor updates any player but cannot delete", func(t *testing.T) {
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo)
+		ctx := principalContext(auth.RoleEditor, "")
+
+		mockRepo.On("GetPlayerByID", mock.Anything, lg.ID).Return(lg, nil)
+		mockRepo.On("UpdatePlayer", mock.Anything, lg).Return(nil)
+
+		assert.NoError(t, service.UpdatePlayer(ctx, lg))
+		assertErrorCode(t, customErrors.ForbiddenError, service.DeletePlayer(ctx, lg.ID))
+		mockRepo.AssertNotCalled(t, "DeletePlayer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("team manager upserts over own team only", func(t *testing.T) {
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo)
+		ctx := principalContext(auth.RoleTeamManager, "기아")
+
+		incoming := []*playerDom.Player{{ID: uuid.New(), Name: "오지환", Sport: "야구", Team: "기아", ExternalID: "kbo-2"}}
+		forbidden := customErrors.NewErrorWithArgs(customErrors.ForbiddenError, "not allowed to update players of team %s", "LG")
+		mockRepo.On("UpsertPlayersByExternalID", mock.Anything, incoming, []string{"기아"}).Return([]playerDom.BatchItemStatus(nil), forbidden)
+
+		_, err := service.CreatePlayers(ctx, incoming, playerDom.BatchModeAtomic, true)
+		assertErrorCode(t, customErrors.ForbiddenError, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestFindDuplicates(t *testing.T) {
+	mockRepo := new(MockPlayerRepository)
+	service := NewPlayerService(mockRepo)
+
+	now := time.Now()
+	players := []*playerDom.Player{
+		{ID: uuid.New(), Name: "김도영", Sport: "야구", Team: "기아", CreatedAt: now.Add(-time.Hour)},
+		{ID: uuid.New(), Name: "김 도영", Sport: "야구", Team: "기아", CreatedAt: now},
+		{ID: uuid.New(), Name: "양현종", Sport: "야구", Team: "기아", CreatedAt: now},
+	}
+
+	withAliases := mock.MatchedBy(func(opts playerDom.ReadOptions) bool { return opts.Expands(playerDom.ExpandAliases) })
+	mockRepo.On("StreamPlayers", mock.Anything, playerDom.PlayerFilter{Sport: "야구"}, withAliases, mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(3).(func(*playerDom.Player) error)
+			for _, p := range players {
+				_ = fn(p)
+			}
+		}).
+		Return(nil)
+
+	candidates, err := service.FindDuplicates(principalContext(auth.RoleViewer, ""), "야구", playerDom.DefaultDuplicateScore, 10)
+
+	assert.NoError(t, err)
+	if assert.Len(t, candidates, 1) {
+		assert.Equal(t, players[0].ID, candidates[0].Player.ID)
+		assert.Equal(t, players[1].ID, candidates[0].Duplicate.ID)
+	}
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMergePlayers(t *testing.T) {
+	survivor := &playerDom.Player{ID: uuid.New(), Name: "김도영", Sport: "야구", Team: "기아"}
+	mergedID := uuid.New()
+
+	t.Run("admin", func(t *testing.T) {
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo)
+
+		mockRepo.On("MergePlayers", mock.Anything, survivor.ID, mergedID).Return("", nil)
+		mockRepo.On("GetPlayerByIDWithOptions", mock.Anything, survivor.ID, playerDom.ReadOptions{}).Return(survivor, nil)
+
+		p, err := service.MergePlayers(adminContext(), survivor.ID, mergedID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, survivor, p)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("deletes the discarded stored image", func(t *testing.T) {
+		root := t.TempDir()
+		store := blob.NewFileSystemStore(root, "/images")
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo, WithImageStore(store))
+
+		var keys []string
+		for _, name := range []string{"64", "256", "512", "original"} {
+			key := "players/" + mergedID.String() + "/abcd/" + name + ".jpg"
+			_, err := store.Put(context.Background(), key, []byte("jpeg"), "image/jpeg")
+			assert.NoError(t, err)
+			keys = append(keys, key)
+		}
+
+		mockRepo.On("MergePlayers", mock.Anything, survivor.ID, mergedID).Return("/images/"+keys[3], nil)
+		mockRepo.On("GetPlayerByIDWithOptions", mock.Anything, survivor.ID, playerDom.ReadOptions{}).Return(survivor, nil)
+
+		_, err := service.MergePlayers(adminContext(), survivor.ID, mergedID)
+
+		assert.NoError(t, err)
+		for _, key := range keys {
+			assert.NoFileExists(t, filepath.Join(root, key))
+		}
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("keeps images of other players", func(t *testing.T) {
+		root := t.TempDir()
+		store := blob.NewFileSystemStore(root, "/images")
+		mockRepo := new(MockPlayerRepository)
+		service := NewPlayerService(mockRepo, WithImageStore(store))
+
+		otherKey := "players/" + uuid.NewString() + "/abcd/original.jpg"
+		_, err := store.Put(context.Background(), otherKey, []byte("jpeg"), "image/jpeg")
+		assert.NoError(t, err)
+
+		// 병합된 선수가 다른 선수의 이미지를 가리키고 있었음
+		mockRepo.On("MergePlayers", mock.Anything, survivor.ID, mergedID).Return("/images/"+otherKey, nil)
+		mockRepo.On("GetPlayerByIDWithOptions", mock.Anything, survivor.ID, playerDom.ReadOptions{}).Return(survivor, nil)
+
+		_, err = service.MergePlayers(adminContext(), survivor.ID, mergedID)
+
+		assert.NoError(t, err)
+		assert.FileExists(t, filepath.Join(root, otherKey))
+	})
+
+	t.Run("into itself", func(t *testing.T) {
+		service := NewPlayerService(new(MockPlayerRepository))
+
+		_, err := service.MergePlayers(adminContext(), survivor.ID, survivor.ID)
+
+		assertErrorCode(t, customErrors.InvalidArgumentError, err)
+	})
+
+	t.Run("editor is forbidden", func(t *testing.T) {
+		service := NewPlayerService(new(MockPlayerRepository))
+
+		_, err := service.MergePlayers(principalContext(auth.RoleEditor, ""), survivor.ID, mergedID)
+
+		assertErrorCode(t, customErrors.ForbiddenError, err)
+	})
+}
+
+func TestGetPlayerByExternalID(t *testing.T) {
+	mockRepo := new(MockPlayerRepository)
+	service := NewPlayerService(mockRepo)
+
+	expected := &playerDom.Player{ID: uuid.New(), Name: "김도영"}
+	opts := playerDom.ReadOptions{Expand: []string{playerDom.ExpandExternalIDs}}
+	mockRepo.On("GetPlayerByExternalID", mock.Anything, "wikidata", "Q42", opts).Return(expected, nil)
+
+	p, err := service.GetPlayerByExternalID(principalContext(auth.RoleViewer, ""), "wikidata", "Q42", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, p)
+	mockRepo.AssertExpectations(t)
+
+	_, err = service.GetPlayerByExternalID(context.Background(), "wikidata", "Q42", opts)
+	assertErrorCode(t, customErrors.UnauthenticatedError, err)
+}
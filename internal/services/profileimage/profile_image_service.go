@@ -0,0 +1,175 @@
+package profileimage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/auth"
+	"player_management_system/internal/pkg/blob"
+	"player_management_system/internal/pkg/imaging"
+	playerRepo "player_management_system/internal/repositories/player"
+)
+
+// ThumbnailSizes are the sides, in pixels, of the square thumbnails generated for every profile image.
+var ThumbnailSizes = []int{64, 256, 512}
+
+// ProfileImageService stores uploaded profile images, so that players no longer link to images
+// hosted on third-party sites.
+type ProfileImageService interface {
+	UploadProfileImage(ctx context.Context, playerID uuid.UUID, data []byte) (*player.ProfileImage, error)
+}
+
+type profileImageService struct {
+	repo  playerRepo.PlayerRepository
+	store blob.Store
+	now   func() time.Time
+}
+
+// NewProfileImageService creates a new ProfileImageService instance.
+func NewProfileImageService(repo playerRepo.PlayerRepository, store blob.Store) ProfileImageService {
+	return &profileImageService{repo: repo, store: store, now: time.Now}
+}
+
+// UploadProfileImage validates and re-encodes an image, stores it with its thumbnails and makes it
+// the player's profile image. The previous image is deleted if it was one the player had uploaded.
+func (s *profileImageService) UploadProfileImage(ctx context.Context, playerID uuid.UUID, data []byte) (*player.ProfileImage, error) {
+	p, err := s.repo.GetPlayerByID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := auth.Authorize(ctx, auth.ActionUploadMedia, p.Team); err != nil {
+		return nil, err
+	}
+
+	result, err := imaging.Process(data, ThumbnailSizes)
+	if err != nil {
+		return nil, err
+	}
+
+	image, stored, err := storeImage(ctx, s.store, playerID, result)
+	if err != nil {
+		return nil, err
+	}
+
+	// 조회 이후 프로필 이미지가 바뀌었으면 교체하지 않아 이전 이미지를 잘못 지우지 않음
+	if err := s.repo.ReplaceProfileImageURL(ctx, playerID, p.ProfileImageURL, image.URL, s.now()); err != nil {
+		cleanup(s.store, stored)
+		return nil, err
+	}
+
+	if p.ProfileImageURL != image.URL {
+		// 이전 이미지 삭제 실패로 업로드를 실패시키지는 않음
+		if err := DeleteStoredImage(ctx, s.store, playerID, p.ProfileImageURL); err != nil {
+			slog.WarnContext(ctx, "failed to delete previous profile image", "player_id", playerID, "url", p.ProfileImageURL, "error", err)
+		}
+	}
+
+	return image, nil
+}
+
+// storeImage stores the renditions of a processed image and returns the stored image with the keys
+// of its blobs. Blobs are keyed by a hash of the image, so a new image never overwrites one that
+// caches may still serve.
+func storeImage(ctx context.Context, store blob.Store, playerID uuid.UUID, result *imaging.Result) (*player.ProfileImage, []string, error) {
+	sum := sha256.Sum256(result.Original.Data)
+	prefix := fmt.Sprintf("players/%s/%s", playerID, hex.EncodeToString(sum[:8]))
+	ext := result.Original.Extension()
+
+	var stored []string
+	put := func(name string, r *imaging.Rendition) (string, error) {
+		key := fmt.Sprintf("%s/%s.%s", prefix, name, ext)
+		url, err := store.Put(ctx, key, r.Data, r.ContentType)
+		if err != nil {
+			return "", err
+		}
+		stored = append(stored, key)
+		return url, nil
+	}
+
+	image := &player.ProfileImage{
+		PlayerID:    playerID,
+		ContentType: result.Original.ContentType,
+		Width:       result.Original.Width,
+		Height:      result.Original.Height,
+	}
+	for _, size := range ThumbnailSizes {
+		url, err := put(fmt.Sprint(size), result.Thumbnails[size])
+		if err != nil {
+			cleanup(store, stored)
+			return nil, nil, err
+		}
+		image.Thumbnails = append(image.Thumbnails, &player.ProfileImageThumbnail{Size: size, URL: url})
+	}
+
+	// 원본은 썸네일이 모두 저장된 뒤에 저장해 선수에 연결되는 URL이 항상 완전하도록 함
+	url, err := put("original", result.Original)
+	if err != nil {
+		cleanup(store, stored)
+		return nil, nil, err
+	}
+	image.URL = url
+
+	return image, stored, nil
+}
+
+// DeleteStoredImage removes the renditions of a player's profile image stored by this package, given
+// the URL of its original. URLs pointing elsewhere, including the images of other players, are left alone.
+func DeleteStoredImage(ctx context.Context, store blob.Store, playerID uuid.UUID, url string) error {
+	key, ok := storedKey(store, url)
+	if !ok || !ownsKey(playerID, key) {
+		return nil
+	}
+	prefix, name := path.Split(key)
+	ext := path.Ext(name)
+	if name != "original"+ext {
+		return nil
+	}
+
+	keys := []string{key}
+	for _, size := range ThumbnailSizes {
+		keys = append(keys, fmt.Sprintf("%s%d%s", prefix, size, ext))
+	}
+	for _, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storedKey returns the key of the blob a URL of our store points to.
+func storedKey(store blob.Store, url string) (string, bool) {
+	base := store.BaseURL()
+	if base == "" {
+		return "", false
+	}
+	return strings.CutPrefix(url, base+"/")
+}
+
+// ownsKey reports whether a blob key lies under the player's own prefix, where storeImage puts the
+// player's images. Keys that are not clean paths could escape the prefix and are never the player's.
+func ownsKey(playerID uuid.UUID, key string) bool {
+	return playerID != uuid.Nil && key == path.Clean(key) && strings.HasPrefix(key, "players/"+playerID.String()+"/")
+}
+
+// cleanup removes the blobs of a failed upload. It runs on its own context because the request's
+// may already be cancelled.
+func cleanup(store blob.Store, keys []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			slog.ErrorContext(ctx, "failed to delete blob", "key", key, "error", err)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package profileimage
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	playerDom "player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/auth"
+	"player_management_system/internal/pkg/blob"
+	customErrors "player_management_system/internal/pkg/errors"
+	playerRepo "player_management_system/internal/repositories/player"
+)
+
+// MockPlayerRepository mocks the repository methods used by the profile image service.
+type MockPlayerRepository struct {
+	mock.Mock
+	playerRepo.PlayerRepository
+}
+
+func (m *MockPlayerRepository) GetPlayerByID(ctx context.Context, id uuid.UUID) (*playerDom.Player, error) {
+	args := m.Called(ctx, id)
+	p, _ := args.Get(0).(*playerDom.Player)
+	return p, args.Error(1)
+}
+
+// failingStore fails every Put after the first few.
+type failingStore struct {
+	blob.Store
+	remaining int
+}
+
+func (s *failingStore) Put(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	if s.remaining == 0 {
+		return "", customErrors.NewError(customErrors.InternalError, "store unavailable")
+	}
+	s.remaining--
+	return s.Store.Put(ctx, key, data, contentType)
+}
+
+func pngImage(t *testing.T, w, h int) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h))))
+	return buf.Bytes()
+}
+
+func editorContext() context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "editor", Roles: []string{auth.RoleEditor}})
+}
+
+func TestUploadProfileImage(t *testing.T) {
+	root := t.TempDir()
+	mockRepo := new(MockPlayerRepository)
+	service := NewProfileImageService(mockRepo, blob.NewFileSystemStore(root, "https://img.example.com"))
+
+	p := &playerDom.Player{ID: uuid.New(), Name: "김도영", Team: "기아"}
+	mockRepo.On("GetPlayerByID", mock.Anything, p.ID).Return(p, nil)
+	mockRepo.On("ReplaceProfileImageURL", mock.Anything, p.ID, "", mock.MatchedBy(func(url string) bool {
+		return strings.HasPrefix(url, "https://img.example.com/players/"+p.ID.String()+"/") && strings.HasSuffix(url, "/original.png")
+	}), mock.Anything).Return(nil)
+
+	uploaded, err := service.UploadProfileImage(editorContext(), p.ID, pngImage(t, 600, 400))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 600, uploaded.Width)
+	if assert.Len(t, uploaded.Thumbnails, len(ThumbnailSizes)) {
+		assert.Equal(t, 64, uploaded.Thumbnails[0].Size)
+		assert.True(t, strings.HasSuffix(uploaded.Thumbnails[0].URL, "/64.png"))
+	}
+
+	// 썸네일과 원본이 모두 저장됨
+	files, _ := filepath.Glob(filepath.Join(root, "players", p.ID.String(), "*", "*.png"))
+	assert.Len(t, files, len(ThumbnailSizes)+1)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUploadProfileImage_DeletesPreviousImage(t *testing.T) {
+	root := t.TempDir()
+	mockRepo := new(MockPlayerRepository)
+	store := blob.NewFileSystemStore(root, "https://img.example.com")
+	service := NewProfileImageService(mockRepo, store)
+
+	p := &playerDom.Player{ID: uuid.New(), Name: "김도영", Team: "기아"}
+	mockRepo.On("GetPlayerByID", mock.Anything, p.ID).Return(p, nil)
+	mockRepo.On("ReplaceProfileImageURL", mock.Anything, p.ID, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	first, err := service.UploadProfileImage(editorContext(), p.ID, pngImage(t, 100, 100))
+	assert.NoError(t, err)
+
+	// 다른 선수의 이미지를 가리키는 경우에는 그 이미지를 남김
+	other := uuid.New()
+	otherURL, err := store.Put(context.Background(), "players/"+other.String()+"/abc/original.png", []byte("x"), "image/png")
+	assert.NoError(t, err)
+	p.ProfileImageURL = otherURL
+	_, err = service.UploadProfileImage(editorContext(), p.ID, pngImage(t, 200, 200))
+	assert.NoError(t, err)
+	assert.FileExists(t, filepath.Join(root, "players", other.String(), "abc", "original.png"))
+
+	p.ProfileImageURL = first.URL
+	second, err := service.UploadProfileImage(editorContext(), p.ID, pngImage(t, 300, 300))
+	assert.NoError(t, err)
+
+	mockRepo.AssertCalled(t, "ReplaceProfileImageURL", mock.Anything, p.ID, first.URL, second.URL, mock.Anything)
+	// 첫 이미지의 원본과 썸네일은 모두 삭제되고 나머지 두 이미지는 남음
+	files, _ := filepath.Glob(filepath.Join(root, "players", p.ID.String(), "*", "*.png"))
+	assert.Len(t, files, 2*(len(ThumbnailSizes)+1))
+	assert.NoFileExists(t, filepath.Join(root, strings.TrimPrefix(first.URL, "https://img.example.com/")))
+}
+
+func TestUploadProfileImage_Forbidden(t *testing.T) {
+	mockRepo := new(MockPlayerRepository)
+	service := NewProfileImageService(mockRepo, blob.NewFileSystemStore(t.TempDir(), ""))
+
+	p := &playerDom.Player{ID: uuid.New(), Team: "LG"}
+	mockRepo.On("GetPlayerByID", mock.Anything, p.ID).Return(p, nil)
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "m", Roles: []string{auth.RoleTeamManager}, Team: "기아"})
+	_, err := service.UploadProfileImage(ctx, p.ID, pngImage(t, 10, 10))
+
+	assert.Equal(t, customErrors.ForbiddenError, err.(*customErrors.Error).Code)
+}
+
+func TestUploadProfileImage_StoreFailureCleansUp(t *testing.T) {
+	root := t.TempDir()
+	mockRepo := new(MockPlayerRepository)
+	store := &failingStore{Store: blob.NewFileSystemStore(root, ""), remaining: 2}
+	service := NewProfileImageService(mockRepo, store)
+
+	p := &playerDom.Player{ID: uuid.New(), Team: "기아"}
+	mockRepo.On("GetPlayerByID", mock.Anything, p.ID).Return(p, nil)
+
+	_, err := service.UploadProfileImage(editorContext(), p.ID, pngImage(t, 100, 100))
+
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "ReplaceProfileImageURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// 먼저 저장된 썸네일은 삭제됨
+	files, _ := filepath.Glob(filepath.Join(root, "players", p.ID.String(), "*", "*"))
+	assert.Empty(t, files)
+}
@@ -0,0 +1,362 @@
+package profileimage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/auth"
+	"player_management_system/internal/pkg/blob"
+	"player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/imaging"
+	"player_management_system/internal/pkg/logging"
+	"player_management_system/internal/pkg/safehttp"
+	playerRepo "player_management_system/internal/repositories/player"
+)
+
+// DefaultFetchTimeout bounds each request for a remote image, including reading its body.
+const DefaultFetchTimeout = 10 * time.Second
+
+const (
+	// mirrorQueueSize bounds the mirror jobs waiting for the worker; further jobs are dropped
+	// and picked up again by the next link check.
+	mirrorQueueSize = 256
+	// linkCheckPageSize is the number of players whose links are read from the database at a time.
+	linkCheckPageSize = 100
+	// linkCheckConcurrency bounds the links checked at once.
+	linkCheckConcurrency = 8
+)
+
+// RemoteImageService handles profile image URLs that point to images hosted elsewhere. It checks
+// the URLs clients submit, mirrors the images into our own storage and periodically checks the
+// links that are still remote, since images on third-party sites tend to disappear.
+type RemoteImageService interface {
+	// CheckURL checks that a profile image URL submitted for a player is ours or points to a public
+	// host. New players are given as uuid.Nil.
+	CheckURL(ctx context.Context, playerID uuid.UUID, rawURL string) error
+	// Mirror queues copying the image at url into our storage and making the copy the player's
+	// profile image. It does nothing when mirroring is disabled or url is not remote. The job is not
+	// bound to ctx; only the request ID it carries is kept for logging.
+	Mirror(ctx context.Context, playerID uuid.UUID, url string)
+	// Run mirrors queued images until ctx is done.
+	Run(ctx context.Context)
+	// CheckLinks checks every remote profile image URL once, records the outcomes and queues the
+	// images that are still available for mirroring.
+	CheckLinks(ctx context.Context) error
+	// RunLinkChecks calls CheckLinks every interval until ctx is done.
+	RunLinkChecks(ctx context.Context, interval time.Duration)
+	// GetBrokenLinks returns the current profile image URLs that the latest check found broken.
+	GetBrokenLinks(ctx context.Context) ([]*player.ProfileImageLinkCheck, error)
+}
+
+// RemoteConfig configures a RemoteImageService.
+type RemoteConfig struct {
+	// Mirror enables copying remote images into our storage.
+	Mirror bool
+	// MaxSize is the size, in bytes, of the largest image mirrored.
+	MaxSize int64
+	// Client fetches remote images. It defaults to a client that refuses to connect to internal addresses.
+	Client *http.Client
+	// Resolver resolves the hosts of submitted URLs. It defaults to net.DefaultResolver.
+	Resolver *net.Resolver
+}
+
+type mirrorJob struct {
+	playerID  uuid.UUID
+	url       string
+	requestID string
+}
+
+type remoteImageService struct {
+	repo  playerRepo.PlayerRepository
+	store blob.Store
+	cfg   RemoteConfig
+	jobs  chan mirrorJob
+	now   func() time.Time
+}
+
+// NewRemoteImageService creates a new RemoteImageService storing mirrored images in store.
+// With mirroring disabled, repo and store may be nil; URLs are then only checked.
+func NewRemoteImageService(repo playerRepo.PlayerRepository, store blob.Store, cfg RemoteConfig) RemoteImageService {
+	if cfg.Client == nil {
+		cfg.Client = safehttp.NewClient(DefaultFetchTimeout)
+	}
+	if cfg.Resolver == nil {
+		cfg.Resolver = net.DefaultResolver
+	}
+	return &remoteImageService{
+		repo:  repo,
+		store: store,
+		cfg:   cfg,
+		jobs:  make(chan mirrorJob, mirrorQueueSize),
+		now:   time.Now,
+	}
+}
+
+// CheckURL implements RemoteImageService. Root-relative paths and URLs of our storage are ours, but
+// URLs of our storage must point to the player's own images, or be the profile image the player
+// already has, such as one taken over in a merge. Otherwise deleting the player's images would
+// delete those of another player.
+func (s *remoteImageService) CheckURL(ctx context.Context, playerID uuid.UUID, rawURL string) error {
+	if rawURL == "" {
+		return nil
+	}
+	if s.store != nil {
+		if key, ok := storedKey(s.store, rawURL); ok {
+			return s.checkStoredURL(ctx, playerID, key, rawURL)
+		}
+	}
+	if s.isOwn(rawURL) {
+		return nil
+	}
+	u, err := safehttp.ParseURL(rawURL)
+	if err != nil {
+		return errors.NewFieldError("profile_image_url", errors.MsgInvalidURL, safehttp.MaxURLLength)
+	}
+	if err := safehttp.CheckURL(ctx, s.cfg.Resolver, rawURL); err != nil {
+		if errors.Is(err, safehttp.ErrForbiddenAddress) {
+			return errors.NewFieldError("profile_image_url", errors.MsgForbiddenAddress)
+		}
+		return errors.NewFieldError("profile_image_url", errors.MsgUnresolvableHost, u.Hostname())
+	}
+	return nil
+}
+
+// checkStoredURL checks that a URL of our storage, whose blob is at key, may be the player's profile image.
+func (s *remoteImageService) checkStoredURL(ctx context.Context, playerID uuid.UUID, key, rawURL string) error {
+	if ownsKey(playerID, key) {
+		return nil
+	}
+	if playerID != uuid.Nil && s.repo != nil {
+		p, err := s.repo.GetPlayerByID(ctx, playerID)
+		if err == nil && p.ProfileImageURL == rawURL {
+			return nil
+		}
+		if err != nil && errors.CodeOf(err) != errors.NotFoundError {
+			return err
+		}
+	}
+	return errors.NewFieldError("profile_image_url", errors.MsgForeignStoredImage)
+}
+
+// isOwn reports whether url points to an image we serve.
+func (s *remoteImageService) isOwn(url string) bool {
+	if strings.HasPrefix(url, "/") && !strings.HasPrefix(url, "//") {
+		return true
+	}
+	if s.store == nil {
+		return false
+	}
+	base := s.store.BaseURL()
+	return base != "" && strings.HasPrefix(url, base+"/")
+}
+
+// Mirror implements RemoteImageService. It never blocks; when the queue is full the job is dropped.
+func (s *remoteImageService) Mirror(ctx context.Context, playerID uuid.UUID, url string) {
+	if !s.cfg.Mirror || url == "" || s.isOwn(url) {
+		return
+	}
+
+	requestID, _ := logging.RequestIDFromContext(ctx)
+	select {
+	case s.jobs <- mirrorJob{playerID: playerID, url: url, requestID: requestID}:
+	default:
+		slog.WarnContext(ctx, "mirror queue is full, skipping profile image", "player_id", playerID)
+	}
+}
+
+// Run implements RemoteImageService.
+func (s *remoteImageService) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-s.jobs:
+			// 복사를 요청한 요청의 ID로 기록함
+			jobCtx := ctx
+			if job.requestID != "" {
+				jobCtx = logging.WithRequestID(ctx, job.requestID)
+			}
+			if err := s.mirror(jobCtx, job); err != nil {
+				slog.ErrorContext(jobCtx, "failed to mirror profile image", "player_id", job.playerID, "url", job.url, "error", err)
+			}
+		}
+	}
+}
+
+// mirror fetches a remote image, stores it with its thumbnails and replaces the player's profile
+// image URL with the stored one, unless the player has been given another URL in the meantime.
+func (s *remoteImageService) mirror(ctx context.Context, job mirrorJob) error {
+	resp, err := s.get(ctx, job.url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.ContentLength > s.cfg.MaxSize {
+		return errors.NewErrorWithArgs(errors.PayloadTooLargeError, "image is larger than %d bytes", s.cfg.MaxSize)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, s.cfg.MaxSize+1))
+	if err != nil {
+		return errors.Wrap(errors.InternalError, "profileimage.mirror", err)
+	}
+	if int64(len(data)) > s.cfg.MaxSize {
+		return errors.NewErrorWithArgs(errors.PayloadTooLargeError, "image is larger than %d bytes", s.cfg.MaxSize)
+	}
+
+	result, err := imaging.Process(data, ThumbnailSizes)
+	if err != nil {
+		return err
+	}
+
+	image, stored, err := storeImage(ctx, s.store, job.playerID, result)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.ReplaceProfileImageURL(ctx, job.playerID, job.url, image.URL, s.now()); err != nil {
+		cleanup(s.store, stored)
+		return err
+	}
+
+	return nil
+}
+
+// get requests a remote image and checks that the response is a successful one carrying an image.
+// The caller closes the body of the returned response.
+func (s *remoteImageService) get(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrapf(errors.InvalidArgumentError, "profileimage.get", err, "invalid URL")
+	}
+	req.Header.Set("Accept", "image/jpeg, image/png, image/webp")
+
+	resp, err := s.cfg.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return resp, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	// 실제 형식은 디코딩할 때 다시 확인함
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if !strings.HasPrefix(mediaType, "image/") {
+		resp.Body.Close()
+		return resp, errors.NewErrorWithArgs(errors.UnsupportedMediaTypeError, "content type %q is not an image", mediaType)
+	}
+
+	return resp, nil
+}
+
+// CheckLinks implements RemoteImageService.
+func (s *remoteImageService) CheckLinks(ctx context.Context) error {
+	var checked, broken int
+	after := uuid.Nil
+	for {
+		page, err := s.repo.GetRemoteProfileImageURLs(ctx, after, linkCheckPageSize)
+		if err != nil {
+			return err
+		}
+
+		checks := make([]*player.ProfileImageLinkCheck, len(page))
+		g, gctx := errgroup.WithContext(ctx)
+		g.SetLimit(linkCheckConcurrency)
+		for i, p := range page {
+			if s.isOwn(p.ProfileImageURL) {
+				continue
+			}
+			g.Go(func() error {
+				check, err := s.checkLink(gctx, p)
+				checks[i] = check
+				return err
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return err
+		}
+
+		for _, check := range checks {
+			if check == nil {
+				continue
+			}
+			checked++
+			if check.Broken {
+				broken++
+			} else {
+				// 아직 살아 있는 이미지는 사라지기 전에 복사해 둠
+				s.Mirror(ctx, check.PlayerID, check.URL)
+			}
+		}
+
+		if len(page) < linkCheckPageSize {
+			break
+		}
+		after = page[len(page)-1].ID
+	}
+
+	slog.InfoContext(ctx, "checked profile image links", "checked", checked, "broken", broken)
+	return nil
+}
+
+// checkLink requests a player's profile image and records whether it is broken.
+func (s *remoteImageService) checkLink(ctx context.Context, p *player.Player) (*player.ProfileImageLinkCheck, error) {
+	check := &player.ProfileImageLinkCheck{PlayerID: p.ID, URL: p.ProfileImageURL}
+
+	resp, err := s.get(ctx, p.ProfileImageURL)
+	if resp != nil {
+		check.StatusCode = resp.StatusCode
+	}
+	if err == nil {
+		resp.Body.Close()
+	} else {
+		// 확인이 중단된 것은 링크가 깨진 것이 아님
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		check.Broken = true
+		check.Error = err.Error()
+	}
+	check.CheckedAt = s.now()
+
+	if err := s.repo.SaveProfileImageLinkCheck(ctx, check); err != nil {
+		return nil, err
+	}
+	return check, nil
+}
+
+// RunLinkChecks implements RemoteImageService.
+func (s *remoteImageService) RunLinkChecks(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.CheckLinks(ctx); err != nil {
+				slog.ErrorContext(ctx, "profile image link check failed", "error", err)
+			}
+		}
+	}
+}
+
+// GetBrokenLinks implements RemoteImageService.
+func (s *remoteImageService) GetBrokenLinks(ctx context.Context) ([]*player.ProfileImageLinkCheck, error) {
+	if err := auth.Authorize(ctx, auth.ActionRead, ""); err != nil {
+		return nil, err
+	}
+	return s.repo.GetBrokenProfileImageLinks(ctx)
+}
@@ -0,0 +1,200 @@
+package profileimage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	playerDom "player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/blob"
+	customErrors "player_management_system/internal/pkg/errors"
+)
+
+func (m *MockPlayerRepository) ReplaceProfileImageURL(ctx context.Context, id uuid.UUID, from, to string, updatedAt time.Time) error {
+	args := m.Called(ctx, id, from, to, updatedAt)
+	return args.Error(0)
+}
+
+func (m *MockPlayerRepository) GetRemoteProfileImageURLs(ctx context.Context, after uuid.UUID, limit int) ([]*playerDom.Player, error) {
+	args := m.Called(ctx, after, limit)
+	players, _ := args.Get(0).([]*playerDom.Player)
+	return players, args.Error(1)
+}
+
+func (m *MockPlayerRepository) SaveProfileImageLinkCheck(ctx context.Context, check *playerDom.ProfileImageLinkCheck) error {
+	args := m.Called(ctx, check)
+	return args.Error(0)
+}
+
+// imageServer serves a PNG at /kim.png, an HTML page at /page.html and 404 elsewhere.
+func imageServer(t *testing.T) *httptest.Server {
+	image := pngImage(t, 300, 200)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/kim.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(image)
+		case "/page.html":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte("<html></html>"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTestRemoteImageService uses the test server's client, since the default client refuses loopback addresses.
+func newTestRemoteImageService(repo *MockPlayerRepository, store blob.Store, server *httptest.Server) *remoteImageService {
+	return NewRemoteImageService(repo, store, RemoteConfig{Mirror: true, MaxSize: 1 << 20, Client: server.Client()}).(*remoteImageService)
+}
+
+func TestRemoteImageService_CheckURL(t *testing.T) {
+	repo := new(MockPlayerRepository)
+	service := NewRemoteImageService(repo, blob.NewFileSystemStore(t.TempDir(), "http://localhost:8080/images"), RemoteConfig{})
+	ctx := context.Background()
+	playerID := uuid.New()
+	own := "http://localhost:8080/images/players/" + playerID.String() + "/abcd/original.png"
+
+	// 직접 제공하는 이미지는 내부 주소여도 허용
+	for _, url := range []string{"", "/images/players/1/original.png", own, "https://8.8.8.8/kim.png"} {
+		assert.NoError(t, service.CheckURL(ctx, playerID, url), url)
+	}
+
+	for _, url := range []string{"http://127.0.0.1/kim.png", "HTTP://[::1]/kim.png", "http://localhost:8080/admin", "http://169.254.169.254/latest/meta-data/"} {
+		err := service.CheckURL(ctx, playerID, url)
+		if assert.Error(t, err, url) {
+			assert.Equal(t, customErrors.InvalidArgumentError, err.(*customErrors.Error).Code)
+		}
+	}
+}
+
+func TestRemoteImageService_CheckURL_StoredImages(t *testing.T) {
+	repo := new(MockPlayerRepository)
+	service := NewRemoteImageService(repo, blob.NewFileSystemStore(t.TempDir(), "/images"), RemoteConfig{})
+	ctx := context.Background()
+	playerID, otherID := uuid.New(), uuid.New()
+	own := "/images/players/" + playerID.String() + "/abcd/original.png"
+	other := "/images/players/" + otherID.String() + "/abcd/original.png"
+	inherited := "/images/players/" + otherID.String() + "/ef01/original.png"
+
+	assert.NoError(t, service.CheckURL(ctx, playerID, own))
+
+	// 병합으로 넘겨받아 이미 연결된 이미지는 유지할 수 있음
+	repo.On("GetPlayerByID", mock.Anything, playerID).Return(&playerDom.Player{ID: playerID, ProfileImageURL: inherited}, nil)
+	assert.NoError(t, service.CheckURL(ctx, playerID, inherited))
+
+	// 다른 선수의 이미지와 경로를 벗어나는 키는 거부함
+	for _, url := range []string{other, "/images/players/" + playerID.String() + "/../" + otherID.String() + "/abcd/original.png"} {
+		err := service.CheckURL(ctx, playerID, url)
+		if assert.Error(t, err, url) {
+			assert.Equal(t, customErrors.InvalidArgumentError, err.(*customErrors.Error).Code)
+		}
+	}
+
+	// 새 선수는 저장된 이미지를 가질 수 없음
+	err := service.CheckURL(ctx, uuid.Nil, own)
+	assert.Error(t, err)
+	repo.AssertNotCalled(t, "GetPlayerByID", mock.Anything, uuid.Nil)
+}
+
+func TestRemoteImageService_Mirror(t *testing.T) {
+	server := imageServer(t)
+	root := t.TempDir()
+	mockRepo := new(MockPlayerRepository)
+	service := newTestRemoteImageService(mockRepo, blob.NewFileSystemStore(root, "https://img.example.com"), server)
+
+	playerID := uuid.New()
+	done := make(chan struct{})
+	mockRepo.On("ReplaceProfileImageURL", mock.Anything, playerID, server.URL+"/kim.png", mock.MatchedBy(func(url string) bool {
+		return strings.HasPrefix(url, "https://img.example.com/players/"+playerID.String()+"/") && strings.HasSuffix(url, "/original.png")
+	}), mock.Anything).Return(nil).Run(func(mock.Arguments) { close(done) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go service.Run(ctx)
+
+	// 이미 저장소에 있는 이미지는 다시 복사하지 않음
+	service.Mirror(context.Background(), playerID, "https://img.example.com/players/1/original.png")
+	service.Mirror(context.Background(), playerID, server.URL+"/kim.png")
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("image was not mirrored")
+	}
+	mockRepo.AssertExpectations(t)
+
+	files, _ := filepath.Glob(filepath.Join(root, "players", playerID.String(), "*", "*.png"))
+	assert.Len(t, files, len(ThumbnailSizes)+1)
+}
+
+func TestRemoteImageService_MirrorRejected(t *testing.T) {
+	server := imageServer(t)
+	root := t.TempDir()
+	mockRepo := new(MockPlayerRepository)
+	service := newTestRemoteImageService(mockRepo, blob.NewFileSystemStore(root, ""), server)
+
+	tests := []struct {
+		name string
+		url  string
+		code customErrors.ErrorCode
+	}{
+		{"not an image", server.URL + "/page.html", customErrors.UnsupportedMediaTypeError},
+		{"too large", server.URL + "/kim.png", customErrors.PayloadTooLargeError},
+	}
+	service.cfg.MaxSize = 100
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.mirror(context.Background(), mirrorJob{playerID: uuid.New(), url: tt.url})
+
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.code, err.(*customErrors.Error).Code)
+			}
+		})
+	}
+
+	err := service.mirror(context.Background(), mirrorJob{playerID: uuid.New(), url: server.URL + "/missing.png"})
+	assert.ErrorContains(t, err, "404")
+
+	mockRepo.AssertNotCalled(t, "ReplaceProfileImageURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	files, _ := filepath.Glob(filepath.Join(root, "*"))
+	assert.Empty(t, files)
+}
+
+func TestRemoteImageService_CheckLinks(t *testing.T) {
+	server := imageServer(t)
+	mockRepo := new(MockPlayerRepository)
+	service := newTestRemoteImageService(mockRepo, blob.NewFileSystemStore(t.TempDir(), "https://img.example.com"), server)
+
+	alive := &playerDom.Player{ID: uuid.New(), ProfileImageURL: server.URL + "/kim.png"}
+	dead := &playerDom.Player{ID: uuid.New(), ProfileImageURL: server.URL + "/gone.png"}
+	own := &playerDom.Player{ID: uuid.New(), ProfileImageURL: "https://img.example.com/players/1/original.png"}
+	mockRepo.On("GetRemoteProfileImageURLs", mock.Anything, uuid.Nil, linkCheckPageSize).Return([]*playerDom.Player{alive, dead, own}, nil)
+	mockRepo.On("SaveProfileImageLinkCheck", mock.Anything, mock.MatchedBy(func(c *playerDom.ProfileImageLinkCheck) bool {
+		return c.PlayerID == alive.ID && !c.Broken && c.StatusCode == http.StatusOK
+	})).Return(nil).Once()
+	mockRepo.On("SaveProfileImageLinkCheck", mock.Anything, mock.MatchedBy(func(c *playerDom.ProfileImageLinkCheck) bool {
+		return c.PlayerID == dead.ID && c.Broken && c.StatusCode == http.StatusNotFound && c.Error != ""
+	})).Return(nil).Once()
+
+	err := service.CheckLinks(context.Background())
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+
+	// 살아 있는 외부 이미지만 복사 대기열에 들어감
+	if assert.Len(t, service.jobs, 1) {
+		assert.Equal(t, alive.ID, (<-service.jobs).playerID)
+	}
+}
@@ -0,0 +1,364 @@
+package roster
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/text/language"
+
+	playerDomain "player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/auth"
+	"player_management_system/internal/pkg/errors"
+	playerService "player_management_system/internal/services/player"
+	"player_management_system/internal/services/profileimage"
+)
+
+// Player fields that can be mapped from roster columns.
+const (
+	FieldName            = "name"
+	FieldSport           = "sport"
+	FieldTeam            = "team"
+	FieldProfileImageURL = "profile_image_url"
+	FieldExternalID      = "external_id"
+	FieldBirthDate       = "birth_date"
+)
+
+// urlCheckConcurrency bounds the profile image URLs checked at the same time.
+const urlCheckConcurrency = 8
+
+var mappableFields = []string{FieldName, FieldSport, FieldTeam, FieldProfileImageURL, FieldExternalID, FieldBirthDate}
+
+// ColumnMapping maps a player field to the header of the roster column holding it.
+// Fields that are not mapped are read from a column with the same name as the field.
+type ColumnMapping map[string]string
+
+// Options controls a roster import.
+type Options struct {
+	Format  Format
+	Mapping ColumnMapping
+	// DryRun validates the roster without storing anything.
+	DryRun bool
+	// Upsert updates existing players matched on their external ID in the default namespace.
+	Upsert bool
+	// Language is the language of the row errors; the zero value means English.
+	Language language.Tag
+}
+
+// RowError describes why a roster row was rejected, as problem details like the errors of requests.
+// Row is the 1-based row number in the file, the header usually being row 1.
+type RowError struct {
+	Row int `json:"row"`
+	*errors.Problem
+}
+
+// Report is the outcome of a roster import.
+type Report struct {
+	DryRun      bool       `json:"dry_run"`
+	TotalRows   int        `json:"total_rows"`
+	ValidRows   int        `json:"valid_rows"`
+	CreatedRows int        `json:"created_rows"`
+	UpdatedRows int        `json:"updated_rows"`
+	Committed   bool       `json:"committed"`
+	Errors      []RowError `json:"errors"`
+
+	lang language.Tag
+}
+
+// Importer imports player rosters from spreadsheets.
+type Importer struct {
+	playerService playerService.PlayerService
+	remoteImages  profileimage.RemoteImageService
+}
+
+// ImporterOption configures an Importer.
+type ImporterOption func(*Importer)
+
+// WithRemoteImages makes the importer check remote profile image URLs and mirror the images of the
+// imported players, like POST /players does.
+func WithRemoteImages(remoteImages profileimage.RemoteImageService) ImporterOption {
+	return func(i *Importer) {
+		i.remoteImages = remoteImages
+	}
+}
+
+// NewImporter creates a new Importer.
+func NewImporter(playerService playerService.PlayerService, opts ...ImporterOption) *Importer {
+	i := &Importer{playerService: playerService}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+// Authorize checks that the principal in ctx may import rosters at all, in either mode. Import checks
+// it first, but transports should check it before receiving the file too, so that clients who may not
+// import cannot make the server read large files or resolve their profile image hosts.
+func (i *Importer) Authorize(ctx context.Context) error {
+	return auth.AuthorizeAnyTeam(ctx, auth.ActionCreate)
+}
+
+// Import validates every row of the roster against the player domain rules, the same ones as
+// POST /players applies, and against the players already stored: without upsert an external ID
+// must not be taken yet, and with upsert the players it would update must be updatable. Nothing is
+// stored in dry-run mode or when any row is invalid; otherwise all rows are stored atomically and
+// their remote profile images are queued for mirroring.
+func (i *Importer) Import(ctx context.Context, r io.Reader, opts Options) (*Report, error) {
+	if err := i.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	records, err := readRecords(r, opts.Format)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.NewError(errors.InvalidArgumentError, "Roster is empty")
+	}
+
+	columns, err := resolveColumns(records[0].values, opts.Mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{DryRun: opts.DryRun, Errors: []RowError{}, lang: opts.Language}
+	var rows []*rosterRow
+	seen := make(map[string]int)
+	for _, rec := range records[1:] {
+		if isBlank(rec.values) {
+			continue
+		}
+		report.TotalRows++
+
+		values := make(map[string]string, len(columns))
+		for field, index := range columns {
+			if index < len(rec.values) {
+				values[field] = strings.TrimSpace(rec.values[index])
+			}
+		}
+
+		row := &rosterRow{number: rec.row}
+		row.player, row.err = newPlayer(values)
+		if row.err == nil {
+			row.err = checkExternalID(row, opts.Upsert, seen)
+		}
+		rows = append(rows, row)
+	}
+
+	if report.TotalRows > playerDomain.MaxBatchSize {
+		return nil, errors.NewErrorWithArgs(errors.InvalidArgumentError, "Roster exceeds %d players", playerDomain.MaxBatchSize)
+	}
+	if err := i.checkExistingPlayers(ctx, rows, opts.Upsert); err != nil {
+		return nil, err
+	}
+	i.checkProfileImageURLs(ctx, rows)
+
+	var (
+		players    []*playerDomain.Player
+		playerRows []int
+	)
+	for _, row := range rows {
+		if row.err != nil {
+			report.addError(row.number, row.err)
+			continue
+		}
+		report.ValidRows++
+		players = append(players, row.player)
+		playerRows = append(playerRows, row.number)
+	}
+
+	if opts.DryRun || len(report.Errors) > 0 || report.ValidRows == 0 {
+		return report, nil
+	}
+
+	statuses, err := i.playerService.CreatePlayers(ctx, players, playerDomain.BatchModeAtomic, opts.Upsert)
+	if err != nil {
+		if statuses == nil {
+			return nil, err
+		}
+		// 이미 존재하는 선수 때문에 전체 가져오기가 롤백됨
+		for n, status := range statuses {
+			if status == playerDomain.BatchItemConflict {
+				report.addError(playerRows[n], errors.NewError(errors.AlreadyExistsError, "player already exists"))
+			}
+		}
+		return report, nil
+	}
+
+	for n, status := range statuses {
+		switch status {
+		case playerDomain.BatchItemCreated:
+			report.CreatedRows++
+		case playerDomain.BatchItemUpdated:
+			report.UpdatedRows++
+		}
+		if i.remoteImages != nil {
+			i.remoteImages.Mirror(ctx, players[n].ID, players[n].ProfileImageURL)
+		}
+	}
+	report.Committed = true
+
+	return report, nil
+}
+
+// rosterRow is a roster row being validated.
+type rosterRow struct {
+	number int
+	player *playerDomain.Player
+	err    error
+}
+
+// newPlayer builds the player of a roster row.
+func newPlayer(values map[string]string) (*playerDomain.Player, error) {
+	p, err := playerDomain.NewPlayer(values[FieldName], values[FieldSport], values[FieldTeam], values[FieldProfileImageURL])
+	if err != nil {
+		return nil, err
+	}
+	if p.BirthDate, err = playerDomain.ParseBirthDate(values[FieldBirthDate]); err != nil {
+		return nil, err
+	}
+	p.ExternalID = values[FieldExternalID]
+	return p, nil
+}
+
+// checkExternalID checks that the external ID of a row is set when upserting and is not used by an
+// earlier row. seen maps the external IDs of the earlier rows to their row numbers.
+func checkExternalID(row *rosterRow, upsert bool, seen map[string]int) error {
+	externalID := row.player.ExternalID
+	if externalID == "" {
+		if upsert {
+			return errors.NewFieldError(FieldExternalID, errors.MsgRequired)
+		}
+		return nil
+	}
+	if first, ok := seen[externalID]; ok {
+		return errors.NewFieldError(FieldExternalID, errors.MsgDuplicateInRoster, first)
+	}
+	seen[externalID] = row.number
+	return nil
+}
+
+// checkExistingPlayers checks the valid rows against the players already linked to their external
+// IDs and records the failures in the rows, so that a dry run reports the rows the commit would reject.
+func (i *Importer) checkExistingPlayers(ctx context.Context, rows []*rosterRow, upsert bool) error {
+	byExternalID := make(map[string]*rosterRow)
+	var externalIDs []string
+	for _, row := range rows {
+		if row.err == nil && row.player.ExternalID != "" {
+			byExternalID[row.player.ExternalID] = row
+			externalIDs = append(externalIDs, row.player.ExternalID)
+		}
+	}
+	if len(externalIDs) == 0 {
+		return nil
+	}
+
+	existing, err := i.playerService.GetPlayersByExternalIDs(ctx, externalIDs)
+	if err != nil {
+		return err
+	}
+	for _, p := range existing {
+		row := byExternalID[p.ExternalID]
+		if upsert {
+			// 커밋할 때와 같이 덮어쓸 선수의 팀도 확인
+			row.err = auth.Authorize(ctx, auth.ActionUpdate, p.Team)
+		} else {
+			row.err = errors.NewFieldError(FieldExternalID, errors.MsgExternalIDTaken)
+		}
+	}
+	return nil
+}
+
+// checkProfileImageURLs checks the profile image URLs of the valid rows concurrently, since each
+// check may resolve a host, and records the failures in the rows.
+func (i *Importer) checkProfileImageURLs(ctx context.Context, rows []*rosterRow) {
+	if i.remoteImages == nil {
+		return
+	}
+
+	var g errgroup.Group
+	g.SetLimit(urlCheckConcurrency)
+	for _, row := range rows {
+		if row.err != nil || row.player.ProfileImageURL == "" {
+			continue
+		}
+		g.Go(func() error {
+			row.err = i.remoteImages.CheckURL(ctx, uuid.Nil, row.player.ProfileImageURL)
+			return nil
+		})
+	}
+	g.Wait()
+}
+
+// addError records a rejected row.
+func (r *Report) addError(row int, err error) {
+	r.Errors = append(r.Errors, RowError{Row: row, Problem: errors.NewLocalizedProblem(err, r.lang)})
+}
+
+// resolveColumns finds the column index of every mapped field in the header row.
+// Header names are matched case-insensitively.
+func resolveColumns(header []string, mapping ColumnMapping) (map[string]int, error) {
+	for field := range mapping {
+		if !slices.Contains(mappableFields, field) {
+			return nil, errors.NewErrorWithArgs(errors.InvalidArgumentError, "Unknown roster field: %s", field)
+		}
+	}
+
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	columns := make(map[string]int, len(mappableFields))
+	for _, field := range mappableFields {
+		name := field
+		if mapped, ok := mapping[field]; ok {
+			name = mapped
+		}
+		i, ok := index[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			if _, mapped := mapping[field]; mapped {
+				return nil, errors.NewErrorWithArgs(errors.InvalidArgumentError, "Column not found: %s", name)
+			}
+			continue
+		}
+		columns[field] = i
+	}
+
+	for _, field := range []string{FieldName, FieldSport, FieldTeam} {
+		if _, ok := columns[field]; !ok {
+			return nil, errors.NewErrorWithArgs(errors.InvalidArgumentError, "Missing column for %s", field)
+		}
+	}
+
+	return columns, nil
+}
+
+// ParseColumnMapping parses a mapping written as field=column pairs separated by commas,
+// for example "name=이름,team=소속팀".
+func ParseColumnMapping(s string) (ColumnMapping, error) {
+	mapping := ColumnMapping{}
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		field, column, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, errors.NewErrorWithArgs(errors.InvalidArgumentError, "Invalid column mapping: %s", pair)
+		}
+		mapping[strings.TrimSpace(field)] = strings.TrimSpace(column)
+	}
+	return mapping, nil
+}
+
+func isBlank(row []string) bool {
+	for _, v := range row {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
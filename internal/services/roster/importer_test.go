@@ -0,0 +1,252 @@
+package roster
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/xuri/excelize/v2"
+	"golang.org/x/text/language"
+
+	playerDom "player_management_system/internal/domains/players"
+	"player_management_system/internal/pkg/auth"
+	customErrors "player_management_system/internal/pkg/errors"
+	playerService "player_management_system/internal/services/player"
+	"player_management_system/internal/services/profileimage"
+)
+
+// MockPlayerService mocks the player operations used by the importer.
+type MockPlayerService struct {
+	mock.Mock
+	playerService.PlayerService
+}
+
+func (m *MockPlayerService) CreatePlayers(ctx context.Context, players []*playerDom.Player, mode playerDom.BatchMode, upsert bool) ([]playerDom.BatchItemStatus, error) {
+	args := m.Called(ctx, players, mode, upsert)
+	return args.Get(0).([]playerDom.BatchItemStatus), args.Error(1)
+}
+
+func (m *MockPlayerService) GetPlayersByExternalIDs(ctx context.Context, externalIDs []string) ([]*playerDom.Player, error) {
+	args := m.Called(ctx, externalIDs)
+	players, _ := args.Get(0).([]*playerDom.Player)
+	return players, args.Error(1)
+}
+
+// principalContext returns a context authenticated with the role.
+func principalContext(role, team string) context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "importer", Roles: []string{role}, Team: team})
+}
+
+// MockRemoteImageService mocks the URL checks and mirroring used by the importer.
+type MockRemoteImageService struct {
+	mock.Mock
+	profileimage.RemoteImageService
+}
+
+func (m *MockRemoteImageService) CheckURL(ctx context.Context, playerID uuid.UUID, rawURL string) error {
+	args := m.Called(ctx, playerID, rawURL)
+	return args.Error(0)
+}
+
+func (m *MockRemoteImageService) Mirror(ctx context.Context, playerID uuid.UUID, url string) {
+	m.Called(ctx, playerID, url)
+}
+
+func TestImport_DryRun(t *testing.T) {
+	mockService := new(MockPlayerService)
+	importer := NewImporter(mockService)
+
+	csv := "\ufeff이름,종목,소속팀\n김도영,야구,기아\n,야구,기아\n\n나성범,,기아\n"
+	mapping := ColumnMapping{FieldName: "이름", FieldSport: "종목", FieldTeam: "소속팀"}
+
+	report, err := importer.Import(principalContext(auth.RoleEditor, ""), strings.NewReader(csv), Options{Format: FormatCSV, Mapping: mapping, DryRun: true})
+	assert.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.False(t, report.Committed)
+	assert.Equal(t, 3, report.TotalRows)
+	assert.Equal(t, 1, report.ValidRows)
+	if assert.Len(t, report.Errors, 2) {
+		assert.Equal(t, 3, report.Errors[0].Row)
+		assert.Equal(t, customErrors.InvalidArgumentError, report.Errors[0].Code)
+		assert.Equal(t, "Invalid argument: name", report.Errors[0].Detail)
+		assert.Equal(t, 5, report.Errors[1].Row)
+		assert.Equal(t, "Invalid argument: sport", report.Errors[1].Detail)
+	}
+
+	mockService.AssertNotCalled(t, "CreatePlayers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImport_Commit(t *testing.T) {
+	mockService := new(MockPlayerService)
+	importer := NewImporter(mockService)
+
+	csv := "name,sport,team,external_id\n김도영,야구,기아,kbo-1\n나성범,야구,기아,kbo-2\n"
+	statuses := []playerDom.BatchItemStatus{playerDom.BatchItemCreated, playerDom.BatchItemUpdated}
+	mockService.On("GetPlayersByExternalIDs", mock.Anything, []string{"kbo-1", "kbo-2"}).
+		Return([]*playerDom.Player{{ID: uuid.New(), Team: "기아", ExternalID: "kbo-2"}}, nil)
+	mockService.On("CreatePlayers", mock.Anything, mock.MatchedBy(func(players []*playerDom.Player) bool {
+		return len(players) == 2 && players[1].ExternalID == "kbo-2"
+	}), playerDom.BatchModeAtomic, true).Return(statuses, nil)
+
+	report, err := importer.Import(principalContext(auth.RoleEditor, ""), strings.NewReader(csv), Options{Format: FormatCSV, Upsert: true})
+	assert.NoError(t, err)
+	assert.True(t, report.Committed)
+	assert.Equal(t, 1, report.CreatedRows)
+	assert.Equal(t, 1, report.UpdatedRows)
+	assert.Empty(t, report.Errors)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestImport_InvalidRowsAreNotCommitted(t *testing.T) {
+	mockService := new(MockPlayerService)
+	importer := NewImporter(mockService)
+
+	csv := "name,sport,team,external_id\n김도영,야구,기아,kbo-1\n김도영,야구,기아,kbo-1\n"
+	mockService.On("GetPlayersByExternalIDs", mock.Anything, []string{"kbo-1"}).Return([]*playerDom.Player{}, nil)
+
+	report, err := importer.Import(principalContext(auth.RoleEditor, ""), strings.NewReader(csv), Options{Format: FormatCSV})
+	assert.NoError(t, err)
+	assert.False(t, report.Committed)
+	if assert.Len(t, report.Errors, 1) {
+		assert.Equal(t, 3, report.Errors[0].Row)
+	}
+
+	mockService.AssertNotCalled(t, "CreatePlayers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImport_DryRunReportsTakenExternalIDs(t *testing.T) {
+	mockService := new(MockPlayerService)
+	mockService.On("GetPlayersByExternalIDs", mock.Anything, []string{"kbo-1", "kbo-2"}).
+		Return([]*playerDom.Player{{ID: uuid.New(), Team: "기아", ExternalID: "kbo-2"}}, nil)
+	importer := NewImporter(mockService)
+
+	csv := "name,sport,team,external_id\n김도영,야구,기아,kbo-1\n나성범,야구,기아,kbo-2\n"
+
+	// 커밋할 때 고유 제약으로 실패할 행을 미리 보고함
+	report, err := importer.Import(principalContext(auth.RoleEditor, ""), strings.NewReader(csv), Options{Format: FormatCSV, DryRun: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.ValidRows)
+	if assert.Len(t, report.Errors, 1) {
+		assert.Equal(t, 3, report.Errors[0].Row)
+		if assert.Len(t, report.Errors[0].Errors, 1) {
+			assert.Equal(t, FieldExternalID, report.Errors[0].Errors[0].Field)
+			assert.Equal(t, "is already used by another player", report.Errors[0].Errors[0].Message)
+		}
+	}
+}
+
+func TestImport_UpsertOfAnotherTeamIsRejected(t *testing.T) {
+	mockService := new(MockPlayerService)
+	mockService.On("GetPlayersByExternalIDs", mock.Anything, []string{"kbo-1"}).
+		Return([]*playerDom.Player{{ID: uuid.New(), Team: "삼성", ExternalID: "kbo-1"}}, nil)
+	importer := NewImporter(mockService)
+
+	csv := "name,sport,team,external_id\n김도영,야구,기아,kbo-1\n"
+
+	report, err := importer.Import(principalContext(auth.RoleTeamManager, "기아"), strings.NewReader(csv), Options{Format: FormatCSV, DryRun: true, Upsert: true})
+	assert.NoError(t, err)
+	if assert.Len(t, report.Errors, 1) {
+		assert.Equal(t, customErrors.ForbiddenError, report.Errors[0].Code)
+	}
+}
+
+func TestImport_Forbidden(t *testing.T) {
+	mockRemote := new(MockRemoteImageService)
+	importer := NewImporter(new(MockPlayerService), WithRemoteImages(mockRemote))
+
+	csv := "name,sport,team,profile_image_url\n김도영,야구,기아,https://example.com/kim.jpg\n"
+
+	// 시험 실행도 파일을 읽기 전에 권한을 확인함
+	for _, ctx := range []context.Context{context.Background(), principalContext(auth.RoleViewer, "")} {
+		_, err := importer.Import(ctx, strings.NewReader(csv), Options{Format: FormatCSV, DryRun: true})
+		assert.Error(t, err)
+	}
+	mockRemote.AssertNotCalled(t, "CheckURL", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImport_MissingColumn(t *testing.T) {
+	importer := NewImporter(new(MockPlayerService))
+
+	_, err := importer.Import(principalContext(auth.RoleEditor, ""), strings.NewReader("name,sport\n김도영,야구\n"), Options{Format: FormatCSV, DryRun: true})
+
+	var customErr *customErrors.Error
+	if assert.ErrorAs(t, err, &customErr) {
+		assert.Equal(t, customErrors.InvalidArgumentError, customErr.Code)
+		assert.Equal(t, "Missing column for team", customErr.Message)
+	}
+}
+
+func TestImport_XLSX(t *testing.T) {
+	f := excelize.NewFile()
+	sheet := f.GetSheetName(0)
+	assert.NoError(t, f.SetSheetRow(sheet, "A1", &[]interface{}{"Name", "Sport", "Team"}))
+	assert.NoError(t, f.SetSheetRow(sheet, "A2", &[]interface{}{"김도영", "야구", "기아"}))
+	var buf bytes.Buffer
+	assert.NoError(t, f.Write(&buf))
+
+	importer := NewImporter(new(MockPlayerService))
+
+	report, err := importer.Import(principalContext(auth.RoleEditor, ""), &buf, Options{Format: FormatXLSX, DryRun: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.ValidRows)
+	assert.Empty(t, report.Errors)
+}
+
+func TestParseColumnMapping(t *testing.T) {
+	mapping, err := ParseColumnMapping("name=이름, team = 소속팀")
+	assert.NoError(t, err)
+	assert.Equal(t, ColumnMapping{FieldName: "이름", FieldTeam: "소속팀"}, mapping)
+
+	_, err = ParseColumnMapping("name")
+	assert.Error(t, err)
+}
+
+func TestImport_SameRulesAsCreatePlayer(t *testing.T) {
+	mockService := new(MockPlayerService)
+	mockRemote := new(MockRemoteImageService)
+	mockRemote.On("CheckURL", mock.Anything, uuid.Nil, "https://example.com/kim.jpg").Return(nil)
+	mockRemote.On("CheckURL", mock.Anything, uuid.Nil, "https://internal.example.com/na.jpg").
+		Return(customErrors.NewFieldError(FieldProfileImageURL, customErrors.MsgForbiddenAddress))
+	importer := NewImporter(mockService, WithRemoteImages(mockRemote))
+
+	csv := "name,sport,team,birth_date,profile_image_url\n" +
+		"김도영,야구,기아,2003-10-02,https://example.com/kim.jpg\n" +
+		"나성범,야구,기아,2999-01-01,\n" +
+		"최형우,야구,기아,,https://internal.example.com/na.jpg\n"
+
+	report, err := importer.Import(principalContext(auth.RoleEditor, ""), strings.NewReader(csv), Options{Format: FormatCSV, DryRun: true, Language: language.Korean})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.ValidRows)
+	if assert.Len(t, report.Errors, 2) {
+		// 행 오류는 요청 언어의 문제 상세 형식으로 보고함
+		assert.Equal(t, 3, report.Errors[0].Row)
+		assert.Equal(t, "잘못된 입력: 생년월일", report.Errors[0].Detail)
+		assert.Equal(t, 4, report.Errors[1].Row)
+		if assert.Len(t, report.Errors[1].Errors, 1) {
+			assert.Equal(t, FieldProfileImageURL, report.Errors[1].Errors[0].Field)
+		}
+	}
+	mockRemote.AssertExpectations(t)
+}
+
+func TestImport_MirrorsCommittedImages(t *testing.T) {
+	mockService := new(MockPlayerService)
+	mockService.On("CreatePlayers", mock.Anything, mock.AnythingOfType("[]*player.Player"), playerDom.BatchModeAtomic, false).
+		Return([]playerDom.BatchItemStatus{playerDom.BatchItemCreated}, nil)
+	mockRemote := new(MockRemoteImageService)
+	mockRemote.On("CheckURL", mock.Anything, uuid.Nil, "https://example.com/kim.jpg").Return(nil)
+	mockRemote.On("Mirror", mock.Anything, mock.AnythingOfType("uuid.UUID"), "https://example.com/kim.jpg").Return()
+	importer := NewImporter(mockService, WithRemoteImages(mockRemote))
+
+	csv := "name,sport,team,profile_image_url\n김도영,야구,기아,https://example.com/kim.jpg\n"
+
+	report, err := importer.Import(principalContext(auth.RoleEditor, ""), strings.NewReader(csv), Options{Format: FormatCSV})
+	assert.NoError(t, err)
+	assert.True(t, report.Committed)
+	mockRemote.AssertExpectations(t)
+}
@@ -0,0 +1,108 @@
+package roster
+
+import (
+	"encoding/csv"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+
+	"player_management_system/internal/pkg/errors"
+)
+
+// Limits on unpacking XLSX files, which are zip archives. A roster of MaxBatchSize players is far
+// smaller, so larger archives are rejected instead of being unpacked into memory or temporary files.
+const (
+	// maxXLSXUnzipSize bounds the total unpacked size of an XLSX file.
+	maxXLSXUnzipSize = 32 << 20
+	// maxXLSXUnzipXMLSize bounds the unpacked size of a worksheet or shared string table kept in memory.
+	maxXLSXUnzipXMLSize = 16 << 20
+)
+
+// Format is the file format of a roster.
+type Format string
+
+// Supported roster formats.
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// FormatFromFilename derives the roster format from a file extension.
+func FormatFromFilename(filename string) (Format, error) {
+	return ParseFormat(strings.TrimPrefix(filepath.Ext(filename), "."))
+}
+
+// ParseFormat parses a roster format name.
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(s)) {
+	case FormatCSV:
+		return FormatCSV, nil
+	case FormatXLSX:
+		return FormatXLSX, nil
+	default:
+		return "", errors.NewErrorWithArgs(errors.InvalidArgumentError, "Unsupported roster format: %s", s)
+	}
+}
+
+// record is a row of a roster file together with its 1-based row number in the file.
+type record struct {
+	row    int
+	values []string
+}
+
+// readRecords reads every row of a roster file, header included.
+// For XLSX only the first sheet is read.
+func readRecords(r io.Reader, format Format) ([]record, error) {
+	switch format {
+	case FormatCSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+
+		var records []record
+		for {
+			values, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, errors.NewErrorWithArgs(errors.InvalidArgumentError, "Invalid CSV file: %v", err)
+			}
+			// 빈 줄은 건너뛰므로 실제 줄 번호를 사용
+			line, _ := reader.FieldPos(0)
+			records = append(records, record{row: line, values: values})
+		}
+		// 엑셀에서 저장한 CSV는 UTF-8 BOM으로 시작함
+		if len(records) > 0 && len(records[0].values) > 0 {
+			records[0].values[0] = strings.TrimPrefix(records[0].values[0], "\ufeff")
+		}
+		return records, nil
+	case FormatXLSX:
+		f, err := excelize.OpenReader(r, excelize.Options{
+			UnzipSizeLimit:    maxXLSXUnzipSize,
+			UnzipXMLSizeLimit: maxXLSXUnzipXMLSize,
+		})
+		if err != nil {
+			return nil, errors.NewErrorWithArgs(errors.InvalidArgumentError, "Invalid XLSX file: %v", err)
+		}
+		defer f.Close()
+
+		sheets := f.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, errors.NewError(errors.InvalidArgumentError, "XLSX file has no sheets")
+		}
+		rows, err := f.GetRows(sheets[0])
+		if err != nil {
+			return nil, errors.NewErrorWithArgs(errors.InvalidArgumentError, "Invalid XLSX file: %v", err)
+		}
+
+		records := make([]record, 0, len(rows))
+		for i, values := range rows {
+			records = append(records, record{row: i + 1, values: values})
+		}
+		return records, nil
+	default:
+		return nil, errors.NewErrorWithArgs(errors.InvalidArgumentError, "Unsupported roster format: %s", format)
+	}
+}
@@ -1,60 +1,246 @@
 package main
 
 import (
-	"log"
-	"net/http"
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 
 	"player_management_system/config"
 	playerHttpHandler "player_management_system/internal/handlers/http"
+	"player_management_system/internal/pkg/auth"
+	"player_management_system/internal/pkg/blob"
+	customErrors "player_management_system/internal/pkg/errors"
+	"player_management_system/internal/pkg/health"
+	"player_management_system/internal/pkg/idempotency"
+	"player_management_system/internal/pkg/logging"
+	"player_management_system/internal/pkg/metrics"
+	"player_management_system/internal/pkg/ratelimit"
+	"player_management_system/internal/pkg/validation"
 	platformPostgres "player_management_system/internal/platform/postgres"
+	"player_management_system/internal/platform/server"
+	"player_management_system/internal/platform/tracing"
+	apiKeyPostgres "player_management_system/internal/repositories/apikey/postgres"
+	idempotencyPostgres "player_management_system/internal/repositories/idempotency/postgres"
+	playerRepoMetrics "player_management_system/internal/repositories/player/instrumented"
 	"player_management_system/internal/repositories/player/postgres"
+	rateLimitPostgres "player_management_system/internal/repositories/ratelimit/postgres"
+	"player_management_system/internal/services/apikey"
 	"player_management_system/internal/services/player"
+	playerServiceTracing "player_management_system/internal/services/player/instrumented"
+	"player_management_system/internal/services/profileimage"
+	"player_management_system/internal/services/roster"
 )
 
 func main() {
 	// Load configuration
 	cfg, err := config.LoadConfig()
 	if err != nil {
-		log.Fatalf("Failed to load configuration: %v", err)
+		fatal("Failed to load configuration", "error", err)
+	}
+
+	// Structured logging; records logged while handling a request carry its request ID and trace ID
+	logger, err := logging.New(logging.Config{
+		Level:       cfg.LogLevel,
+		Format:      cfg.LogFormat,
+		SampleRatio: cfg.LogSampleRatio,
+	}, os.Stdout)
+	if err != nil {
+		fatal("Failed to configure logging", "error", err)
+	}
+	slog.SetDefault(logger)
+	if cfg.EnvFile == "" {
+		slog.Info(".env file not found, using environment variables or defaults")
+	}
+
+	// Tracing of the requests, the player service and the SQL statements
+	tracerProvider, err := tracing.New(tracing.Config{
+		ServiceName: cfg.TracingServiceName,
+		Endpoint:    cfg.TracingEndpoint,
+		SampleRatio: cfg.TracingSampleRatio,
+	})
+	if err != nil {
+		fatal("Failed to configure tracing", "error", err)
 	}
 
 	// Database configuration
 	dbConfig := platformPostgres.Config{
-		Host:     cfg.DBHost,
-		Port:     cfg.DBPort,
-		User:     cfg.DBUser,
-		Password: cfg.DBPassword,
-		DBName:   cfg.DBName,
+		Host:           cfg.DBHost,
+		Port:           cfg.DBPort,
+		User:           cfg.DBUser,
+		Password:       cfg.DBPassword,
+		DBName:         cfg.DBName,
+		TracerProvider: tracerProvider,
 	}
 
 	// Connect to the database
 	db, err := platformPostgres.New(dbConfig)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		fatal("Failed to connect to database", "error", err)
+	}
+
+	// Metrics of the HTTP requests, the connection pool, the repository and the players
+	registry := metrics.NewRegistry()
+	registry.MustRegister(collectors.NewDBStatsCollector(db.DB, cfg.DBName))
+	httpMetrics := metrics.NewHTTPMetrics(registry)
+
+	// Image storage
+	var imageStore blob.Store
+	switch cfg.ImageStore {
+	case "local":
+		imageStore = blob.NewFileSystemStore(cfg.ImageLocalDir, cfg.ImageBaseURL)
+	case "s3":
+		imageStore = blob.NewS3Store(blob.S3Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			PublicURL:       cfg.S3PublicURL,
+		}, nil)
+	default:
+		fatal("Unknown image store", "store", cfg.ImageStore)
 	}
-	defer db.Close()
 
 	// Create repository, service, and handler
-	playerRepo := postgres.NewPlayerRepository(db)
-	playerService := player.NewPlayerService(playerRepo)
-	playerHandler := playerHttpHandler.NewPlayerHandler(playerService)
+	playerRepo := playerRepoMetrics.NewPlayerRepository(postgres.NewPlayerRepository(db), metrics.NewRepositoryMetrics(registry))
+	playersGauge := metrics.NewPlayersGauge(registry, playerRepo.CountPlayersBySport)
+	playerService := playerServiceTracing.NewPlayerService(player.NewPlayerService(playerRepo, player.WithImageStore(imageStore)), tracerProvider)
+	apiKeyService := apikey.NewAPIKeyService(apiKeyPostgres.NewAPIKeyRepository(db))
+	apiKeyHandler := playerHttpHandler.NewAPIKeyHandler(apiKeyService)
+
+	// Bearer token verification
+	jwtVerifier, err := auth.NewJWTVerifier(auth.JWTConfig{
+		HMACSecret:       cfg.JWTHMACSecret,
+		RSAPublicKeyFile: cfg.JWTRSAPublicKeyFile,
+		JWKSFile:         cfg.JWTJWKSFile,
+		Issuer:           cfg.JWTIssuer,
+		Audience:         cfg.JWTAudience,
+	})
+	if err != nil {
+		fatal("Failed to configure JWT authentication", "error", err)
+	}
+
+	// Rate limit storage
+	var rateLimitStore ratelimit.Store
+	switch cfg.RateLimitStore {
+	case "memory":
+		rateLimitStore = ratelimit.NewMemoryStore()
+	case "postgres":
+		rateLimitStore = rateLimitPostgres.NewRateLimitStore(db)
+	default:
+		fatal("Unknown rate limit store", "store", cfg.RateLimitStore)
+	}
+
+	// Idempotency key storage
+	var idempotencyStore idempotency.Store
+	switch cfg.IdempotencyStore {
+	case "memory":
+		idempotencyStore = idempotency.NewMemoryStore()
+	case "postgres":
+		idempotencyStore = idempotencyPostgres.NewIdempotencyStore(db)
+	default:
+		fatal("Unknown idempotency store", "store", cfg.IdempotencyStore)
+	}
+
+	remoteImages := profileimage.NewRemoteImageService(playerRepo, imageStore, profileimage.RemoteConfig{
+		Mirror:  cfg.ImageMirrorRemote,
+		MaxSize: cfg.ImageMaxSize,
+	})
+	profileImageHandler := playerHttpHandler.NewProfileImageHandler(profileimage.NewProfileImageService(playerRepo, imageStore), remoteImages, cfg.ImageMaxSize)
+	playerHandler := playerHttpHandler.NewPlayerHandler(playerService, playerHttpHandler.WithRemoteImages(remoteImages))
+	rosterImportHandler := playerHttpHandler.NewRosterImportHandler(roster.NewImporter(playerService, roster.WithRemoteImages(remoteImages)))
+
+	// Readiness checks, failing once shutdown starts
+	healthChecker := health.NewChecker(cfg.HealthCheckTimeout)
+	healthChecker.Register("database", platformPostgres.HealthCheck(db))
+	healthHandler := playerHttpHandler.NewHealthHandler(healthChecker)
+
+	// Background jobs, stopped after in-flight requests are drained
+	workers := []server.Worker{remoteImages.Run, func(ctx context.Context) {
+		playersGauge.Run(ctx, cfg.MetricsRefreshInterval)
+	}}
+	if sweeper, ok := rateLimitStore.(ratelimit.Sweeper); ok {
+		workers = append(workers, func(ctx context.Context) {
+			ratelimit.RunSweeps(ctx, sweeper, cfg.RateLimitSweepInterval)
+		})
+	}
+	if sweeper, ok := idempotencyStore.(idempotency.Sweeper); ok {
+		workers = append(workers, func(ctx context.Context) {
+			idempotency.RunSweeps(ctx, sweeper, cfg.IdempotencySweepInterval)
+		})
+	}
+	if cfg.ImageLinkCheckInterval > 0 {
+		workers = append(workers, func(ctx context.Context) {
+			remoteImages.RunLinkChecks(ctx, cfg.ImageLinkCheckInterval)
+		})
+	}
+
+	// Client IP addresses, used by the IP rate limit
+	ipExtractor, err := playerHttpHandler.IPExtractor(cfg.TrustedProxies)
+	if err != nil {
+		fatal("Failed to configure trusted proxies", "error", err)
+	}
 
 	// Create Echo instance
 	e := echo.New()
+	e.HTTPErrorHandler = customErrors.HTTPErrorHandler
+	e.Validator = validation.New()
+	e.IPExtractor = ipExtractor
 
 	// Middleware
-	e.Use(middleware.Logger())
+	e.Use(playerHttpHandler.RequestID())
+	e.Use(playerHttpHandler.Tracing(tracerProvider))
+	e.Use(playerHttpHandler.RequestLogger(logger))
+	e.Use(playerHttpHandler.Metrics(httpMetrics))
 	e.Use(middleware.Recover())
+	e.Use(playerHttpHandler.IPRateLimit(rateLimitStore, ratelimit.Limit{Rate: cfg.RateLimitIPRate, Burst: cfg.RateLimitIPBurst}))
+	e.Use(playerHttpHandler.APIKeyAuth(apiKeyService))
+	e.Use(playerHttpHandler.JWTAuth(jwtVerifier))
+	e.Use(playerHttpHandler.RateLimit(rateLimitStore, playerHttpHandler.RateLimitConfig{
+		Read:  ratelimit.Limit{Rate: cfg.RateLimitReadRate, Burst: cfg.RateLimitReadBurst},
+		Write: ratelimit.Limit{Rate: cfg.RateLimitWriteRate, Burst: cfg.RateLimitWriteBurst},
+	}))
+	e.Use(playerHttpHandler.Idempotency(idempotencyStore, cfg.IdempotencyTTL))
 
 	// Routes
+	healthHandler.RegisterRoutes(e)
 	playerHandler.RegisterRoutes(e)
+	rosterImportHandler.RegisterRoutes(e)
+	apiKeyHandler.RegisterRoutes(e)
+	profileImageHandler.RegisterRoutes(e)
+
+	// Admin server of the metrics, kept off the public port
+	admin := echo.New()
+	admin.HideBanner = true
+	admin.HTTPErrorHandler = customErrors.HTTPErrorHandler
+	playerHttpHandler.RegisterMetricsRoute(admin, metrics.Handler(registry))
+	workers = append(workers, server.Serve(admin, ":"+cfg.MetricsPort))
+
+	// Start server; SIGTERM and SIGINT shut it down gracefully
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	// Start server
-	log.Printf("Starting server on port %s", cfg.Port)
-	if err := e.Start(":" + cfg.Port); err != nil && err != http.ErrServerClosed {
-		e.Logger.Fatal("shutting down the server")
+	slog.Info("starting server", "port", cfg.Port, "metrics_port", cfg.MetricsPort)
+	err = server.Run(ctx, e, server.Config{
+		Addr:            ":" + cfg.Port,
+		ShutdownTimeout: cfg.ShutdownTimeout,
+		OnShutdown:      healthChecker.Shutdown,
+		ShutdownDelay:   cfg.ShutdownDelay,
+	}, workers, db, tracerProvider)
+	if err != nil {
+		fatal("Server stopped", "error", err)
 	}
+	slog.Info("server stopped")
+}
+
+// fatal logs an error and exits.
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
 }
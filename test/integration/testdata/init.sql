@@ -1,11 +1,23 @@
 -- test/integration/testdata/init.sql
 
+CREATE TABLE IF NOT EXISTS teams (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    sport TEXT NOT NULL,
+    city TEXT,
+    stadium TEXT,
+    logo_url TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
 CREATE TABLE IF NOT EXISTS players (
     id UUID PRIMARY KEY,
     name TEXT NOT NULL,
     sport TEXT NOT NULL,
     team TEXT NOT NULL,
     profile_image_url TEXT,
+    birth_date DATE,
     created_at TIMESTAMP WITH TIME ZONE NOT NULL,
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL
 );
@@ -29,4 +41,124 @@ CREATE TABLE IF NOT EXISTS media (
     thumbnail_url TEXT,
     created_at TIMESTAMP WITH TIME ZONE NOT NULL,
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL
-);
\ No newline at end of file
+);
+
+CREATE TABLE IF NOT EXISTS player_season_stats (
+    player_id UUID REFERENCES players(id),
+    season INTEGER NOT NULL,
+    games_played INTEGER NOT NULL DEFAULT 0,
+    stats JSONB NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (player_id, season)
+);
+
+CREATE TABLE IF NOT EXISTS player_injuries (
+    id UUID PRIMARY KEY,
+    player_id UUID REFERENCES players(id),
+    status TEXT NOT NULL,
+    description TEXT,
+    injured_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expected_return_at TIMESTAMP WITH TIME ZONE,
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    owner TEXT NOT NULL,
+    prefix TEXT NOT NULL,
+    key_hash TEXT NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key TEXT PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    full_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Buckets created before full_at are deleted by the next sweep, which refills them.
+ALTER TABLE rate_limit_buckets ADD COLUMN IF NOT EXISTS full_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+CREATE INDEX IF NOT EXISTS rate_limit_buckets_full_at_idx ON rate_limit_buckets (full_at);
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key TEXT PRIMARY KEY,
+    owner TEXT NOT NULL,
+    request_hash TEXT NOT NULL,
+    completed BOOLEAN NOT NULL DEFAULT FALSE,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    headers TEXT[] NOT NULL DEFAULT '{}',
+    body BYTEA,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- idempotency_keys.content_type moved into the replayed headers.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS headers TEXT[] NOT NULL DEFAULT '{}';
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'idempotency_keys' AND column_name = 'content_type') THEN
+        UPDATE idempotency_keys SET headers = ARRAY['Content-Type: ' || content_type] WHERE content_type <> '';
+        ALTER TABLE idempotency_keys DROP COLUMN content_type;
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+
+CREATE TABLE IF NOT EXISTS player_redirects (
+    from_id UUID PRIMARY KEY,
+    to_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    merged_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS player_external_ids (
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    namespace TEXT NOT NULL,
+    value TEXT NOT NULL,
+    UNIQUE (namespace, value),
+    UNIQUE (player_id, namespace)
+);
+
+-- players.external_id moved to player_external_ids under the default namespace.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'players' AND column_name = 'external_id') THEN
+        INSERT INTO player_external_ids (player_id, namespace, value)
+        SELECT id, 'default', external_id FROM players WHERE external_id IS NOT NULL
+        ON CONFLICT DO NOTHING;
+        ALTER TABLE players DROP COLUMN external_id;
+    END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS player_aliases (
+    id UUID PRIMARY KEY,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('legal', 'romanized', 'nickname', 'former_name')),
+    locale TEXT NOT NULL DEFAULT '',
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS player_aliases_player_id_idx ON player_aliases (player_id);
+CREATE UNIQUE INDEX IF NOT EXISTS player_aliases_primary_idx ON player_aliases (player_id, locale) WHERE is_primary;
+
+CREATE TABLE IF NOT EXISTS profile_image_link_checks (
+    player_id UUID PRIMARY KEY REFERENCES players(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    broken BOOLEAN NOT NULL,
+    checked_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- The schema version checked by /readyz; raise it together with postgres.SchemaVersion.
+CREATE TABLE IF NOT EXISTS schema_version (
+    version INTEGER PRIMARY KEY,
+    applied_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO schema_version (version) VALUES (3) ON CONFLICT DO NOTHING;